@@ -4,6 +4,7 @@ go 1.19
 
 require (
 	github.com/go-openapi/strfmt v0.21.3
+	github.com/google/uuid v1.3.0
 	github.com/stretchr/testify v1.8.4
 	github.com/weaviate/weaviate v1.22.2
 	golang.org/x/oauth2 v0.8.0
@@ -31,7 +32,6 @@ require (
 	github.com/go-openapi/validate v0.21.0 // indirect
 	github.com/golang/protobuf v1.5.3 // indirect
 	github.com/google/btree v1.0.0 // indirect
-	github.com/google/uuid v1.3.0 // indirect
 	github.com/hashicorp/errwrap v1.1.0 // indirect
 	github.com/hashicorp/go-immutable-radix v1.0.0 // indirect
 	github.com/hashicorp/go-msgpack v0.5.3 // indirect
@@ -71,6 +71,9 @@ func TestBatchCreate_gRPC_integration(t *testing.T) {
 			assert.Equal(t, 3, len(batchResultSlice))
 
 			for i := range objects {
+				assert.Equal(t, objects[i].ID, batchResultSlice[i].ID)
+				require.NotNil(t, batchResultSlice[i].Result)
+				assert.Nil(t, batchResultSlice[i].Result.Errors)
 				objs, err := client.Data().ObjectsGetter().
 					WithID(objects[i].ID.String()).
 					WithClassName(objects[i].Class).
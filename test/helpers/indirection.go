@@ -7,3 +7,11 @@ func StringPointer(s string) *string {
 func Float64Pointer(f float64) *float64 {
 	return &f
 }
+
+func Int64Pointer(i int64) *int64 {
+	return &i
+}
+
+func BoolPointer(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,88 @@
+package connection
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate"
+)
+
+func TestWaitForReady(t *testing.T) {
+	t.Run("ready after a few attempts", func(t *testing.T) {
+		var calls int32
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v1/.well-known/ready", func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{}`))
+		})
+		s := httptest.NewServer(mux)
+		defer s.Close()
+
+		client := weaviate.New(weaviate.Config{Host: strings.TrimPrefix(s.URL, "http://"), Scheme: "http"})
+		err := client.WaitForReady(context.Background(), weaviate.WaitOptions{InitialBackoff: time.Millisecond})
+		assert.Nil(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("live probe", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v1/.well-known/live", func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{}`))
+		})
+		s := httptest.NewServer(mux)
+		defer s.Close()
+
+		client := weaviate.New(weaviate.Config{Host: strings.TrimPrefix(s.URL, "http://"), Scheme: "http"})
+		err := client.WaitForReady(context.Background(), weaviate.WaitOptions{Probe: weaviate.ProbeLive})
+		assert.Nil(t, err)
+	})
+
+	t.Run("cancelled while waiting for a class", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v1/.well-known/ready", func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{}`))
+		})
+		mux.HandleFunc("/v1/meta", func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"modules":{"text2vec-contextionary":{}}}`))
+		})
+		mux.HandleFunc("/v1/schema", func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"classes":[{"class":"Pizza"}]}`))
+		})
+		s := httptest.NewServer(mux)
+		defer s.Close()
+
+		client := weaviate.New(weaviate.Config{Host: strings.TrimPrefix(s.URL, "http://"), Scheme: "http"})
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		err := client.WaitForReady(ctx, weaviate.WaitOptions{
+			InitialBackoff: 10 * time.Millisecond,
+			Classes:        []string{"Pizza", "Soup"},
+			Modules:        []string{"text2vec-contextionary"},
+		})
+		require.NotNil(t, err)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		var waitErr *weaviate.WaitError
+		require.True(t, errors.As(err, &waitErr))
+		assert.Equal(t, []string{"Soup"}, waitErr.MissingClasses)
+		assert.Empty(t, waitErr.MissingModules)
+		assert.Greater(t, waitErr.Attempts, 1)
+		assert.EqualError(t, waitErr.LastErr, "missing classes Soup")
+	})
+
+	t.Run("gRPC probe without gRPC", func(t *testing.T) {
+		client := weaviate.New(weaviate.Config{Host: "localhost:1", Scheme: "http"})
+		err := client.WaitForReady(context.Background(), weaviate.WaitOptions{Probe: weaviate.ProbeGRPCHealth})
+		assert.NotNil(t, err)
+	})
+}
@@ -3,8 +3,10 @@ package batch
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/data"
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/pathbuilder"
 	"github.com/weaviate/weaviate/entities/models"
@@ -16,17 +18,35 @@ type ObjectsBatchRequestBody struct {
 	Objects []*models.Object `json:"objects"`
 }
 
+// objectsWithVectorsBatchRequestBody wrapping objects with named vectors to a batch
+type objectsWithVectorsBatchRequestBody struct {
+	Fields  []string                  `json:"fields"`
+	Objects []*data.ObjectWithVectors `json:"objects"`
+}
+
 // ObjectsBatcher builder to add multiple objects in one batch
 type ObjectsBatcher struct {
 	connection       *connection.Connection
 	grpcClient       *connection.GrpcClient
 	objects          []*models.Object
+	vectors          []data.Vectors
 	consistencyLevel string
 }
 
 // WithObjects adds objects to the batch
 func (ob *ObjectsBatcher) WithObjects(object ...*models.Object) *ObjectsBatcher {
 	ob.objects = append(ob.objects, object...)
+	ob.vectors = append(ob.vectors, make([]data.Vectors, len(object))...)
+	return ob
+}
+
+// WithObjectsWithVectors adds objects with named vectors to the batch. The gRPC protocol
+// of this client can't transfer named vectors, so a batch containing any is sent via REST.
+func (ob *ObjectsBatcher) WithObjectsWithVectors(objects ...*data.ObjectWithVectors) *ObjectsBatcher {
+	for _, object := range objects {
+		ob.objects = append(ob.objects, object.Object)
+		ob.vectors = append(ob.vectors, object.Vectors)
+	}
 	return ob
 }
 
@@ -47,22 +67,63 @@ func (ob *ObjectsBatcher) WithConsistencyLevel(cl string) *ObjectsBatcher {
 
 func (ob *ObjectsBatcher) resetObjects() {
 	ob.objects = []*models.Object{}
+	ob.vectors = nil
+}
+
+func (ob *ObjectsBatcher) hasVectors() bool {
+	for _, vectors := range ob.vectors {
+		if len(vectors) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ObjectsBatchResult is the result of adding a batch of objects
+type ObjectsBatchResult struct {
+	// Objects are aligned with the objects added to the batcher
+	Objects []models.ObjectsGetResponse
+	// Took is the time weaviate reported for processing the batch.
+	// It is only reported via gRPC and zero for batches sent via REST.
+	Took time.Duration
 }
 
 // Do add all the objects in the builder to weaviate
 func (ob *ObjectsBatcher) Do(ctx context.Context) ([]models.ObjectsGetResponse, error) {
+	result, err := ob.DoWithResult(ctx)
+	if result == nil {
+		return nil, err
+	}
+	return result.Objects, err
+}
+
+// DoWithResult adds all the objects in the builder to weaviate and additionally
+// returns the processing time reported by weaviate. If a gRPC reply reports errors
+// that can't be matched to an object, the result is returned together with an error.
+func (ob *ObjectsBatcher) DoWithResult(ctx context.Context) (*ObjectsBatchResult, error) {
 	defer ob.resetObjects()
-	if ob.grpcClient != nil {
+	if ob.grpcClient != nil && !ob.hasVectors() {
 		return ob.runGRPC(ctx)
 	}
-	return ob.runREST(ctx)
+	objects, err := ob.runREST(ctx)
+	if objects == nil {
+		return nil, err
+	}
+	return &ObjectsBatchResult{Objects: objects}, err
 }
 
 func (ob *ObjectsBatcher) runREST(ctx context.Context) ([]models.ObjectsGetResponse, error) {
-	body := ObjectsBatchRequestBody{
+	var body interface{} = ObjectsBatchRequestBody{
 		Fields:  []string{"ALL"},
 		Objects: ob.objects,
 	}
+	if ob.hasVectors() {
+		objects := make([]*data.ObjectWithVectors, len(ob.objects))
+		for i := range ob.objects {
+			objects[i] = &data.ObjectWithVectors{Object: ob.objects[i], Vectors: ob.vectors[i]}
+		}
+		body = objectsWithVectorsBatchRequestBody{Fields: []string{"ALL"}, Objects: objects}
+	}
 	path := pathbuilder.BatchObjects(pathbuilder.Components{
 		ConsistencyLevel: ob.consistencyLevel,
 	})
@@ -77,6 +138,10 @@ func (ob *ObjectsBatcher) runREST(ctx context.Context) ([]models.ObjectsGetRespo
 	return parsedResponse, parseErr
 }
 
-func (ob *ObjectsBatcher) runGRPC(ctx context.Context) ([]models.ObjectsGetResponse, error) {
-	return ob.grpcClient.BatchObjects(ctx, ob.objects, ob.consistencyLevel)
+func (ob *ObjectsBatcher) runGRPC(ctx context.Context) (*ObjectsBatchResult, error) {
+	result, err := ob.grpcClient.BatchObjectsWithResult(ctx, ob.objects, ob.consistencyLevel)
+	if result == nil {
+		return nil, err
+	}
+	return &ObjectsBatchResult{Objects: result.Objects, Took: result.Took}, err
 }
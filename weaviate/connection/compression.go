@@ -0,0 +1,123 @@
+package connection
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"sync/atomic"
+
+	"google.golang.org/grpc/stats"
+)
+
+const defaultMinCompressionSize = 1024
+
+// CompressionConfig configures gzip compression of the REST requests and responses
+type CompressionConfig struct {
+	// Requests enables gzip compression of request bodies
+	Requests bool
+	// MinRequestSize in bytes a request body needs to have to get compressed. Defaults to 1024.
+	MinRequestSize int
+	// Responses requests gzip compressed responses from weaviate
+	Responses bool
+}
+
+// CompressionStats of the data sent and received by the client
+type CompressionStats struct {
+	// RequestBytes is the size of the request payloads before compression
+	RequestBytes int64
+	// CompressedRequestBytes is the size of the request payloads sent
+	CompressedRequestBytes int64
+	// ResponseBytes is the size of the response payloads after decompression
+	ResponseBytes int64
+	// CompressedResponseBytes is the size of the response payloads received
+	CompressedResponseBytes int64
+}
+
+// BytesSaved by compressing requests and responses
+func (s CompressionStats) BytesSaved() int64 {
+	return s.RequestBytes - s.CompressedRequestBytes + s.ResponseBytes - s.CompressedResponseBytes
+}
+
+// Add returns the sum of both stats
+func (s CompressionStats) Add(other CompressionStats) CompressionStats {
+	return CompressionStats{
+		RequestBytes:            s.RequestBytes + other.RequestBytes,
+		CompressedRequestBytes:  s.CompressedRequestBytes + other.CompressedRequestBytes,
+		ResponseBytes:           s.ResponseBytes + other.ResponseBytes,
+		CompressedResponseBytes: s.CompressedResponseBytes + other.CompressedResponseBytes,
+	}
+}
+
+type compressionCounter struct {
+	requestBytes            atomic.Int64
+	compressedRequestBytes  atomic.Int64
+	responseBytes           atomic.Int64
+	compressedResponseBytes atomic.Int64
+}
+
+func (c *compressionCounter) countRequest(size, compressedSize int) {
+	c.requestBytes.Add(int64(size))
+	c.compressedRequestBytes.Add(int64(compressedSize))
+}
+
+func (c *compressionCounter) countResponse(size, compressedSize int) {
+	c.responseBytes.Add(int64(size))
+	c.compressedResponseBytes.Add(int64(compressedSize))
+}
+
+func (c *compressionCounter) stats() CompressionStats {
+	return CompressionStats{
+		RequestBytes:            c.requestBytes.Load(),
+		CompressedRequestBytes:  c.compressedRequestBytes.Load(),
+		ResponseBytes:           c.responseBytes.Load(),
+		CompressedResponseBytes: c.compressedResponseBytes.Load(),
+	}
+}
+
+// TagRPC implements stats.Handler
+func (c *compressionCounter) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+// HandleRPC implements stats.Handler and counts the payload sizes of gRPC calls
+func (c *compressionCounter) HandleRPC(_ context.Context, s stats.RPCStats) {
+	switch payload := s.(type) {
+	case *stats.OutPayload:
+		c.countRequest(payload.Length, payload.CompressedLength)
+	case *stats.InPayload:
+		c.countResponse(payload.Length, payload.CompressedLength)
+	}
+}
+
+// TagConn implements stats.Handler
+func (c *compressionCounter) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn implements stats.Handler
+func (c *compressionCounter) HandleConn(context.Context, stats.ConnStats) {}
+
+func gzipCompress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// countingReader counts the bytes read from the underlying reader
+type countingReader struct {
+	reader io.Reader
+	n      int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.n += n
+	return n, err
+}
@@ -5,43 +5,209 @@ import (
 	"crypto/tls"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
 
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/data/replication"
 	"github.com/weaviate/weaviate/entities/models"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type GrpcClient struct {
-	client  pb.WeaviateClient
-	headers map[string]string
+	nodes *nodePool
+	// conns of the nodes by their address
+	conns       map[string]*grpc.ClientConn
+	headers     map[string]string
+	lifecycle   *lifecycle
+	compression bool
+	counter     *compressionCounter
 }
 
 func NewGrpcClient(scheme, host string, headers map[string]string) (*GrpcClient, error) {
-	client, err := createClient(scheme, host)
+	return NewLoadBalancedGrpcClient(scheme, []string{host}, headers, LoadBalancingConfig{})
+}
+
+// NewLoadBalancedGrpcClient creates a gRPC client which spreads its calls across the given hosts
+// of the same weaviate cluster according to the policy of the load balancing config. Hosts whose
+// calls fail because they are unavailable are ejected for the configured ejection time.
+// The NodeHosts of the config are ignored, as they map node names to REST hosts.
+func NewLoadBalancedGrpcClient(scheme string, hosts []string, headers map[string]string,
+	loadBalancing LoadBalancingConfig,
+) (*GrpcClient, error) {
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("create grpc client: no host given")
+	}
+	loadBalancing.NodeHosts = nil
+	nodes := newNodePool(hosts, loadBalancing, func(host string) string {
+		return getAddress(scheme, host)
+	})
+	counter := &compressionCounter{}
+	conns := make(map[string]*grpc.ClientConn, len(nodes.nodes))
+	for _, n := range nodes.nodes {
+		// only a single node is waited for, so that an unavailable node of a cluster is
+		// ejected instead of blocking the creation of the client
+		conn, err := createConn(scheme, n.address, len(nodes.nodes) == 1, grpc.WithStatsHandler(counter))
+		if err != nil {
+			for _, created := range conns {
+				created.Close()
+			}
+			return nil, fmt.Errorf("create grpc client: %w", err)
+		}
+		conns[n.address] = conn
+	}
+	return &GrpcClient{
+		nodes:     nodes,
+		conns:     conns,
+		headers:   headers,
+		lifecycle: newLifecycle(),
+		counter:   counter,
+	}, nil
+}
+
+// pick returns the node which serves the next call and its connection
+func (c *GrpcClient) pick() (*node, *grpc.ClientConn) {
+	n := c.nodes.pick("")
+	return n, c.conns[n.address]
+}
+
+// report the outcome of a call served by the node. Nodes which are unavailable are ejected,
+// a cancelled or expired context is not the fault of the node.
+func (c *GrpcClient) report(ctx context.Context, n *node, start time.Time, err error) {
+	failed := err != nil && ctx.Err() == nil && isGrpcNodeFailure(err)
+	c.nodes.report(n, time.Since(start), failed)
+}
+
+func isGrpcNodeFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// SetCompression enables gzip compression of gRPC calls
+func (c *GrpcClient) SetCompression(enabled bool) {
+	c.compression = enabled
+}
+
+// CompressionStats returns the number of bytes sent and received before and after compression
+func (c *GrpcClient) CompressionStats() CompressionStats {
+	return c.counter.stats()
+}
+
+// Close cancels all calls in flight and closes the underlying gRPC connections.
+// Every call made afterwards fails with fault.ErrClientClosed.
+func (c *GrpcClient) Close() error {
+	if !c.lifecycle.close() {
+		return nil
+	}
+	var err error
+	for _, conn := range c.conns {
+		if closeErr := conn.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// CheckHealth calls the gRPC health service of weaviate and returns an error if it is not serving
+func (c *GrpcClient) CheckHealth(ctx context.Context) error {
+	ctx, done, err := c.lifecycle.begin(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("create grpc client: %w", err)
+		return err
+	}
+	defer done()
+	n, conn := c.pick()
+	start := time.Now()
+	reply, err := healthpb.NewHealthClient(conn).Check(c.ctxWithHeaders(ctx), &healthpb.HealthCheckRequest{})
+	c.report(ctx, n, start, err)
+	if err != nil {
+		return err
+	}
+	if reply.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("gRPC health status: %s", reply.Status)
 	}
-	return &GrpcClient{client, headers}, nil
+	return nil
 }
 
+// BatchObjectsResult is the result of a gRPC batch request
+type BatchObjectsResult struct {
+	// Objects are aligned with the submitted objects: Objects[i] describes objects[i]
+	Objects []models.ObjectsGetResponse
+	// Took is the time weaviate reported for processing the batch
+	Took time.Duration
+}
+
+// BatchObjects sends the objects to weaviate in one gRPC batch request. The result is
+// aligned with objects, see BatchObjectsWithResult for the processing time and errors
+// which can't be matched to an object.
 func (c *GrpcClient) BatchObjects(ctx context.Context, objects []*models.Object,
 	consistencyLevel string,
 ) ([]models.ObjectsGetResponse, error) {
-	batchRequest, err := c.getBatchRequest(objects, consistencyLevel)
+	result, err := c.BatchObjectsWithResult(ctx, objects, consistencyLevel)
+	if result == nil {
+		return nil, err
+	}
+	return result.Objects, nil
+}
+
+// BatchObjectsWithResult sends the objects to weaviate in one gRPC batch request. If the reply
+// reports an error for an index that doesn't match any submitted object, the
+// result is returned together with an error listing the unmatched errors.
+func (c *GrpcClient) BatchObjectsWithResult(ctx context.Context, objects []*models.Object,
+	consistencyLevel string,
+) (*BatchObjectsResult, error) {
+	ctx, done, err := c.lifecycle.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	ids := c.getObjectIDs(objects)
+	batchRequest, err := c.getBatchRequest(objects, ids, consistencyLevel)
+	if err != nil {
+		return nil, err
+	}
+	n, conn := c.pick()
+	start := time.Now()
+	reply, err := pb.NewWeaviateClient(conn).BatchObjects(c.ctxWithHeaders(ctx), batchRequest, c.getOptions()...)
+	c.report(ctx, n, start, err)
 	if err != nil {
 		return nil, err
 	}
-	reply, err := c.client.BatchObjects(c.ctxWithHeaders(ctx), batchRequest, c.getOptions()...)
-	return c.parseReply(reply, objects), err
+	return c.parseReply(reply, objects, ids)
 }
 
-func (c *GrpcClient) getBatchRequest(objects []*models.Object, consistencyLevel string) (*pb.BatchObjectsRequest, error) {
-	batchObjects, err := c.getBatchObjects(objects)
+// getObjectIDs returns the UUIDs under which the objects are going to be stored.
+// Objects without an ID get a generated UUID, so that it can be reported back
+// to the caller the same way the REST batch endpoint does.
+func (c *GrpcClient) getObjectIDs(objects []*models.Object) []strfmt.UUID {
+	ids := make([]strfmt.UUID, len(objects))
+	for i, obj := range objects {
+		if obj.ID != "" {
+			ids[i] = obj.ID
+		} else {
+			ids[i] = strfmt.UUID(uuid.New().String())
+		}
+	}
+	return ids
+}
+
+func (c *GrpcClient) getBatchRequest(objects []*models.Object, ids []strfmt.UUID,
+	consistencyLevel string,
+) (*pb.BatchObjectsRequest, error) {
+	batchObjects, err := c.getBatchObjects(objects, ids)
 	if err != nil {
 		return nil, err
 	}
@@ -59,10 +225,13 @@ func (c *GrpcClient) ctxWithHeaders(ctx context.Context) context.Context {
 }
 
 func (c *GrpcClient) getOptions() []grpc.CallOption {
+	if c.compression {
+		return []grpc.CallOption{grpc.UseCompressor(gzip.Name)}
+	}
 	return []grpc.CallOption{}
 }
 
-func (c *GrpcClient) getBatchObjects(objects []*models.Object) ([]*pb.BatchObject, error) {
+func (c *GrpcClient) getBatchObjects(objects []*models.Object, ids []strfmt.UUID) ([]*pb.BatchObject, error) {
 	result := make([]*pb.BatchObject, len(objects))
 	for i, obj := range objects {
 		properties, err := c.getProperties(obj.Properties)
@@ -70,7 +239,7 @@ func (c *GrpcClient) getBatchObjects(objects []*models.Object) ([]*pb.BatchObjec
 			return nil, err
 		}
 		batchObject := &pb.BatchObject{
-			Uuid:       obj.ID.String(),
+			Uuid:       ids[i].String(),
 			Collection: obj.Class,
 			Vector:     obj.Vector,
 			Tenant:     obj.Tenant,
@@ -241,39 +410,58 @@ func (c *GrpcClient) getConsistencyLevel(consistencyLevel string) *pb.Consistenc
 	}
 }
 
-func (c *GrpcClient) parseReply(reply *pb.BatchObjectsReply, objects []*models.Object) []models.ObjectsGetResponse {
-	if reply != nil && len(reply.Errors) > 0 {
-		result := make([]models.ObjectsGetResponse, len(reply.Errors))
-		for i, res := range reply.Errors {
-			var errors *models.ErrorResponse
-			if res.Error != "" {
-				errors = &models.ErrorResponse{
-					Error: []*models.ErrorResponseErrorItems0{
-						{Message: res.Error},
-					},
-				}
-			}
-			failed := models.ObjectsGetResponseAO2ResultStatusFAILED
-			result[i] = models.ObjectsGetResponse{
-				Result: &models.ObjectsGetResponseAO2Result{
-					Errors: errors,
-					Status: &failed,
-				},
-			}
-		}
-		return result
-	}
-	// all is OK
-	success := models.ObjectsGetResponseAO2ResultStatusSUCCESS
-	result := make([]models.ObjectsGetResponse, len(objects))
-	for i := range objects {
-		result[i] = models.ObjectsGetResponse{
+// parseReply maps the reply onto the submitted objects. The result is aligned
+// with objects: result[i] describes objects[i] and carries its UUID, class,
+// tenant, properties and vector like the REST batch response does. Objects
+// reported in reply.Errors are marked as FAILED with the server's message,
+// all others as SUCCESS. The gRPC reply does not contain per-object timestamps,
+// so creation and update times are left unset. Errors with an index outside of
+// objects are reported in the returned error instead of being dropped.
+func (c *GrpcClient) parseReply(reply *pb.BatchObjectsReply, objects []*models.Object,
+	ids []strfmt.UUID,
+) (*BatchObjectsResult, error) {
+	result := &BatchObjectsResult{Objects: make([]models.ObjectsGetResponse, len(objects))}
+	for i, obj := range objects {
+		success := models.ObjectsGetResponseAO2ResultStatusSUCCESS
+		result.Objects[i] = models.ObjectsGetResponse{
+			Object: models.Object{
+				Class:      obj.Class,
+				ID:         ids[i],
+				Tenant:     obj.Tenant,
+				Properties: obj.Properties,
+				Vector:     obj.Vector,
+			},
 			Result: &models.ObjectsGetResponseAO2Result{
 				Status: &success,
 			},
 		}
 	}
-	return result
+	if reply == nil {
+		return result, nil
+	}
+	result.Took = time.Duration(float64(reply.Took) * float64(time.Second))
+	var unmatched []string
+	for _, res := range reply.Errors {
+		index := int(res.Index)
+		if index < 0 || index >= len(result.Objects) {
+			unmatched = append(unmatched, fmt.Sprintf("index %d: %s", res.Index, res.Error))
+			continue
+		}
+		failed := models.ObjectsGetResponseAO2ResultStatusFAILED
+		result.Objects[index].Result = &models.ObjectsGetResponseAO2Result{
+			Errors: &models.ErrorResponse{
+				Error: []*models.ErrorResponseErrorItems0{
+					{Message: res.Error},
+				},
+			},
+			Status: &failed,
+		}
+	}
+	if len(unmatched) > 0 {
+		return result, fmt.Errorf("batch reply contains errors for unknown objects: %s",
+			strings.Join(unmatched, "; "))
+	}
+	return result, nil
 }
 
 func toInt64Array[T int | int32 | int64 | uint | uint32 | uint64](arr []T) []int64 {
@@ -284,10 +472,11 @@ func toInt64Array[T int | int32 | int64 | uint | uint32 | uint64](arr []T) []int
 	return result
 }
 
-func createClient(scheme, host string) (pb.WeaviateClient, error) {
-	var opts []grpc.DialOption
-	opts = append(opts, grpc.WithBlock())
-	if scheme == "https" || strings.HasSuffix(host, ":443") {
+func createConn(scheme, address string, block bool, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	if block {
+		opts = append(opts, grpc.WithBlock())
+	}
+	if useTLS(scheme, address) {
 		tlsConfig := &tls.Config{
 			InsecureSkipVerify: true,
 		}
@@ -295,11 +484,15 @@ func createClient(scheme, host string) (pb.WeaviateClient, error) {
 	} else {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
-	conn, err := grpc.Dial(getAddress(scheme, host), opts...)
+	conn, err := grpc.Dial(address, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to dial: %w", err)
 	}
-	return pb.NewWeaviateClient(conn), nil
+	return conn, nil
+}
+
+func useTLS(scheme, host string) bool {
+	return scheme == "https" || strings.HasSuffix(host, ":443")
 }
 
 func getAddress(scheme, host string) string {
@@ -0,0 +1,131 @@
+package connection
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestGrpcClient_getObjectIDs(t *testing.T) {
+	objects := []*models.Object{
+		{Class: "Pizza", ID: "abefd256-8574-442b-9293-9205193737ee"},
+		{Class: "Pizza"},
+	}
+	ids := (&GrpcClient{}).getObjectIDs(objects)
+	require.Len(t, ids, 2)
+	assert.Equal(t, strfmt.UUID("abefd256-8574-442b-9293-9205193737ee"), ids[0])
+	assert.True(t, strfmt.IsUUID(ids[1].String()))
+	assert.Empty(t, objects[1].ID)
+}
+
+func TestGrpcClient_parseReply(t *testing.T) {
+	objects := []*models.Object{
+		{Class: "Pizza", Tenant: "tenantA", Properties: map[string]interface{}{"name": "Hawaii"}},
+		{Class: "Pizza", Properties: map[string]interface{}{"name": "Doener"}},
+		{Class: "Soup", Vector: []float32{0.1, 0.2}},
+	}
+	ids := []strfmt.UUID{
+		"abefd256-8574-442b-9293-9205193737ee",
+		"5b6a08ba-1d46-43aa-89cc-8b070790c6f2",
+		"565da3b6-60b3-40e5-ba21-e6bfe5dbba91",
+	}
+	success := models.ObjectsGetResponseAO2ResultStatusSUCCESS
+	failed := models.ObjectsGetResponseAO2ResultStatusFAILED
+
+	t.Run("all objects succeeded", func(t *testing.T) {
+		reply, err := (&GrpcClient{}).parseReply(&pb.BatchObjectsReply{Took: 0.25}, objects, ids)
+		require.NoError(t, err)
+		assert.Equal(t, 250*time.Millisecond, reply.Took)
+		result := reply.Objects
+		require.Len(t, result, 3)
+		for i := range result {
+			assert.Equal(t, ids[i], result[i].ID)
+			assert.Equal(t, objects[i].Class, result[i].Class)
+			assert.Equal(t, objects[i].Tenant, result[i].Tenant)
+			assert.Equal(t, objects[i].Properties, result[i].Properties)
+			assert.Equal(t, objects[i].Vector, result[i].Vector)
+			assert.Zero(t, result[i].CreationTimeUnix)
+			assert.Zero(t, result[i].LastUpdateTimeUnix)
+			require.NotNil(t, result[i].Result)
+			assert.Equal(t, &success, result[i].Result.Status)
+			assert.Nil(t, result[i].Result.Errors)
+		}
+	})
+
+	t.Run("errors are mapped to the objects by index", func(t *testing.T) {
+		reply := &pb.BatchObjectsReply{
+			Errors: []*pb.BatchObjectsReply_BatchError{
+				{Index: 1, Error: "invalid property"},
+			},
+		}
+		parsed, err := (&GrpcClient{}).parseReply(reply, objects, ids)
+		require.NoError(t, err)
+		result := parsed.Objects
+		require.Len(t, result, 3)
+		assert.Equal(t, &success, result[0].Result.Status)
+		assert.Nil(t, result[0].Result.Errors)
+		assert.Equal(t, &failed, result[1].Result.Status)
+		require.NotNil(t, result[1].Result.Errors)
+		require.Len(t, result[1].Result.Errors.Error, 1)
+		assert.Equal(t, "invalid property", result[1].Result.Errors.Error[0].Message)
+		assert.Equal(t, ids[1], result[1].ID)
+		assert.Equal(t, &success, result[2].Result.Status)
+	})
+
+	t.Run("errors with unknown indexes are reported", func(t *testing.T) {
+		reply := &pb.BatchObjectsReply{
+			Errors: []*pb.BatchObjectsReply_BatchError{
+				{Index: 0, Error: "invalid property"},
+				{Index: 7, Error: "out of range"},
+			},
+		}
+		parsed, err := (&GrpcClient{}).parseReply(reply, objects, ids)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "index 7: out of range")
+		require.NotNil(t, parsed)
+		require.Len(t, parsed.Objects, 3)
+		assert.Equal(t, &failed, parsed.Objects[0].Result.Status)
+		assert.Equal(t, &success, parsed.Objects[1].Result.Status)
+	})
+}
+
+func TestUseTLS(t *testing.T) {
+	assert.True(t, useTLS("https", "node1:50051"))
+	assert.True(t, useTLS("http", "node2:443"))
+	assert.False(t, useTLS("http", "node1:50051"))
+}
+
+func TestGrpcClient_loadBalanced(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(listener)
+	defer server.Stop()
+
+	closed, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	unavailable := closed.Addr().String()
+	closed.Close()
+
+	client, err := NewLoadBalancedGrpcClient("http", []string{unavailable, listener.Addr().String()},
+		nil, LoadBalancingConfig{})
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.Error(t, client.CheckHealth(context.Background()))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, client.CheckHealth(context.Background()))
+	}
+	assert.True(t, client.nodes.nodes[0].ejectedUntil.After(time.Now()))
+}
@@ -0,0 +1,61 @@
+package connection
+
+import (
+	"context"
+	"sync"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/fault"
+)
+
+// lifecycle keeps track of the requests in flight, so that they can be cancelled
+// once the client is closed
+type lifecycle struct {
+	sync.Mutex
+	closed   bool
+	nextID   uint64
+	inFlight map[uint64]context.CancelFunc
+	done     chan struct{}
+}
+
+func newLifecycle() *lifecycle {
+	return &lifecycle{
+		inFlight: map[uint64]context.CancelFunc{},
+		done:     make(chan struct{}),
+	}
+}
+
+// begin registers a request and derives its context. The returned func must be
+// called once the request has finished. Returns fault.ErrClientClosed if the client has been closed.
+func (l *lifecycle) begin(ctx context.Context) (context.Context, func(), error) {
+	l.Lock()
+	defer l.Unlock()
+	if l.closed {
+		return nil, nil, fault.ErrClientClosed
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	id := l.nextID
+	l.nextID++
+	l.inFlight[id] = cancel
+	return ctx, func() {
+		l.Lock()
+		delete(l.inFlight, id)
+		l.Unlock()
+		cancel()
+	}, nil
+}
+
+// close cancels all requests in flight. Returns false if it has been closed before.
+func (l *lifecycle) close() bool {
+	l.Lock()
+	defer l.Unlock()
+	if l.closed {
+		return false
+	}
+	l.closed = true
+	for id, cancel := range l.inFlight {
+		cancel()
+		delete(l.inFlight, id)
+	}
+	close(l.done)
+	return true
+}
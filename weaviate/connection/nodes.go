@@ -0,0 +1,187 @@
+package connection
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LoadBalancingPolicy decides which node serves the next request
+type LoadBalancingPolicy string
+
+const (
+	// RoundRobin cycles through all healthy nodes
+	RoundRobin LoadBalancingPolicy = "round_robin"
+	// LeastLatency sends requests to the healthy node with the lowest observed latency
+	LeastLatency LoadBalancingPolicy = "least_latency"
+)
+
+const (
+	defaultEjectionTime = 30 * time.Second
+	// weight of the latest request duration in the moving latency average of a node
+	latencyWeight = 0.2
+)
+
+// LoadBalancingConfig configures how REST and gRPC requests are spread across several weaviate
+// nodes. The nodes are given by the client config, they are not discovered: the nodes status
+// endpoint of weaviate doesn't report the addresses of the nodes.
+type LoadBalancingConfig struct {
+	// Policy used to pick a node for a request. Defaults to RoundRobin.
+	Policy LoadBalancingPolicy
+	// EjectionTime for which a failing node does not receive any requests. Defaults to 30 seconds.
+	EjectionTime time.Duration
+	// NodeHosts maps weaviate node names to their hosts. Requests for a specific node,
+	// e.g. ObjectsGetter.WithNodeName, are sent to the mapped host. The mapping is also
+	// used to resolve the nodes reported by the nodes status endpoint when refreshing nodes.
+	NodeHosts map[string]string
+	// RefreshNodes refreshes the health of the nodes listed in NodeHosts through the nodes status
+	// endpoint when the client is created. It doesn't add nodes which are not listed and
+	// only applies to REST requests.
+	RefreshNodes bool
+}
+
+type node struct {
+	name string
+	// address requests are sent to, the base path of REST or host and port of gRPC requests
+	address      string
+	latency      time.Duration
+	ejectedUntil time.Time
+}
+
+// nodePool keeps track of the nodes of a cluster and their health
+type nodePool struct {
+	sync.Mutex
+	address      func(host string) string
+	policy       LoadBalancingPolicy
+	ejectionTime time.Duration
+	nodes        []*node
+	next         int
+}
+
+// newNodePool of the hosts and the hosts of config.NodeHosts, address returns the address
+// requests to a host are sent to
+func newNodePool(hosts []string, config LoadBalancingConfig, address func(host string) string) *nodePool {
+	pool := &nodePool{
+		address:      address,
+		policy:       config.Policy,
+		ejectionTime: config.EjectionTime,
+	}
+	if pool.policy == "" {
+		pool.policy = RoundRobin
+	}
+	if pool.ejectionTime <= 0 {
+		pool.ejectionTime = defaultEjectionTime
+	}
+	for _, host := range hosts {
+		pool.addNode("", host)
+	}
+	for name, host := range config.NodeHosts {
+		pool.addNode(name, host)
+	}
+	return pool
+}
+
+// addNode adds the host to the pool or updates the node name of an already known host
+func (p *nodePool) addNode(name, host string) *node {
+	address := p.address(host)
+	for _, n := range p.nodes {
+		if n.address == address {
+			if name != "" {
+				n.name = name
+			}
+			return n
+		}
+	}
+	n := &node{name: name, address: address}
+	p.nodes = append(p.nodes, n)
+	return n
+}
+
+// setNode registers a node under the given name and marks it as healthy or ejected
+func (p *nodePool) setNode(name, host string, healthy bool) {
+	p.Lock()
+	defer p.Unlock()
+	n := p.addNode(name, host)
+	if healthy {
+		n.ejectedUntil = time.Time{}
+	} else {
+		n.ejectedUntil = time.Now().Add(p.ejectionTime)
+	}
+}
+
+// pick returns the node that should serve a request for the given path.
+// Requests which specify a node_name are routed to that node if it is known.
+func (p *nodePool) pick(path string) *node {
+	p.Lock()
+	defer p.Unlock()
+	if len(p.nodes) == 1 {
+		return p.nodes[0]
+	}
+	if name := nodeNameFromPath(path); name != "" {
+		for _, n := range p.nodes {
+			if n.name == name {
+				return n
+			}
+		}
+	}
+	now := time.Now()
+	var healthy []*node
+	for _, n := range p.nodes {
+		if !now.Before(n.ejectedUntil) {
+			healthy = append(healthy, n)
+		}
+	}
+	if len(healthy) == 0 {
+		// all nodes are ejected, try the one which comes back first
+		next := p.nodes[0]
+		for _, n := range p.nodes[1:] {
+			if n.ejectedUntil.Before(next.ejectedUntil) {
+				next = n
+			}
+		}
+		return next
+	}
+	if p.policy == LeastLatency {
+		fastest := healthy[0]
+		for _, n := range healthy[1:] {
+			if n.latency < fastest.latency {
+				fastest = n
+			}
+		}
+		return fastest
+	}
+	n := healthy[p.next%len(healthy)]
+	p.next++
+	return n
+}
+
+// report records the outcome of a request served by the node
+func (p *nodePool) report(n *node, took time.Duration, failed bool) {
+	p.Lock()
+	defer p.Unlock()
+	if failed {
+		if len(p.nodes) > 1 {
+			n.ejectedUntil = time.Now().Add(p.ejectionTime)
+		}
+		return
+	}
+	n.ejectedUntil = time.Time{}
+	if n.latency == 0 {
+		n.latency = took
+	} else {
+		n.latency = time.Duration(latencyWeight*float64(took) + (1-latencyWeight)*float64(n.latency))
+	}
+}
+
+func nodeNameFromPath(path string) string {
+	i := strings.Index(path, "?")
+	if i < 0 {
+		return ""
+	}
+	query, err := url.ParseQuery(path[i+1:])
+	if err != nil {
+		return ""
+	}
+	return query.Get("node_name")
+}
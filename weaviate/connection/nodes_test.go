@@ -0,0 +1,92 @@
+package connection
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodePool_pick(t *testing.T) {
+	t.Run("round robin", func(t *testing.T) {
+		pool := newNodePool([]string{"node1:8080", "node2:8080"}, LoadBalancingConfig{}, restBasePath("http"))
+		assert.Equal(t, "http://node1:8080/v1", pool.pick("/objects").address)
+		assert.Equal(t, "http://node2:8080/v1", pool.pick("/objects").address)
+		assert.Equal(t, "http://node1:8080/v1", pool.pick("/objects").address)
+	})
+
+	t.Run("ejected nodes are skipped", func(t *testing.T) {
+		pool := newNodePool([]string{"node1:8080", "node2:8080"}, LoadBalancingConfig{}, restBasePath("http"))
+		pool.report(pool.nodes[0], time.Millisecond, true)
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, "http://node2:8080/v1", pool.pick("/objects").address)
+		}
+		pool.report(pool.nodes[1], time.Millisecond, true)
+		pool.nodes[0].ejectedUntil = time.Now().Add(time.Second)
+		assert.Equal(t, "http://node1:8080/v1", pool.pick("/objects").address)
+	})
+
+	t.Run("least latency", func(t *testing.T) {
+		pool := newNodePool([]string{"node1:8080", "node2:8080"}, LoadBalancingConfig{Policy: LeastLatency}, restBasePath("http"))
+		pool.report(pool.nodes[0], 50*time.Millisecond, false)
+		pool.report(pool.nodes[1], 10*time.Millisecond, false)
+		assert.Equal(t, "http://node2:8080/v1", pool.pick("/objects").address)
+		assert.Equal(t, "http://node2:8080/v1", pool.pick("/objects").address)
+	})
+
+	t.Run("node specific requests", func(t *testing.T) {
+		pool := newNodePool([]string{"node1:8080"}, LoadBalancingConfig{
+			NodeHosts: map[string]string{"weaviate-1": "node2:8080"},
+		}, restBasePath("http"))
+		require.Len(t, pool.nodes, 2)
+		path := "/objects/Pizza/abefd256-8574-442b-9293-9205193737ee?node_name=weaviate-1"
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, "http://node2:8080/v1", pool.pick(path).address)
+		}
+	})
+
+	t.Run("set node", func(t *testing.T) {
+		pool := newNodePool([]string{"node1:8080"}, LoadBalancingConfig{}, restBasePath("http"))
+		pool.setNode("weaviate-0", "node1:8080", true)
+		pool.setNode("weaviate-1", "node2:8080", false)
+		require.Len(t, pool.nodes, 2)
+		assert.Equal(t, "weaviate-0", pool.nodes[0].name)
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, "http://node1:8080/v1", pool.pick("/objects").address)
+		}
+	})
+}
+
+func TestConnection_RunRESTLoadBalanced(t *testing.T) {
+	var healthyCalls int
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		healthyCalls++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthy.Close()
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+
+	hosts := []string{
+		strings.TrimPrefix(unavailable.URL, "http://"),
+		strings.TrimPrefix(healthy.URL, "http://"),
+	}
+	con := NewLoadBalancedConnection("http", hosts, nil, nil, LoadBalancingConfig{})
+
+	response, err := con.RunREST(context.Background(), "/meta", http.MethodGet, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	for i := 0; i < 4; i++ {
+		response, err = con.RunREST(context.Background(), "/meta", http.MethodGet, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+	}
+	assert.Equal(t, 4, healthyCalls)
+}
@@ -2,6 +2,7 @@ package connection
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
@@ -9,7 +10,6 @@ import (
 	"io"
 	"log"
 	"net/http"
-	"runtime"
 	"time"
 
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/fault"
@@ -20,32 +20,38 @@ const apiVersion = "v1"
 
 // Connection networking layer accessing weaviate using http requests
 type Connection struct {
-	basePath   string
-	httpClient *http.Client
-	headers    map[string]string
-	doneCh     chan bool
-}
-
-func finalizer(c *Connection) {
-	c.doneCh <- true
+	nodes       *nodePool
+	httpClient  *http.Client
+	headers     map[string]string
+	lifecycle   *lifecycle
+	compression CompressionConfig
+	counter     *compressionCounter
 }
 
 // NewConnection based on scheme://host
 // if httpClient is nil a default client will be used
 func NewConnection(scheme string, host string, httpClient *http.Client, headers map[string]string) *Connection {
+	return NewLoadBalancedConnection(scheme, []string{host}, httpClient, headers, LoadBalancingConfig{})
+}
+
+// NewLoadBalancedConnection based on scheme and a list of hosts of the same weaviate cluster.
+// Requests are spread across the hosts according to the given load balancing config.
+// if httpClient is nil a default client will be used
+func NewLoadBalancedConnection(scheme string, hosts []string, httpClient *http.Client,
+	headers map[string]string, loadBalancing LoadBalancingConfig,
+) *Connection {
 	client := httpClient
 	if client == nil {
 		client = &http.Client{}
 	}
 	connection := &Connection{
-		basePath:   scheme + "://" + host + "/" + apiVersion,
+		nodes:      newNodePool(hosts, loadBalancing, restBasePath(scheme)),
 		httpClient: client,
 		headers:    headers,
-		doneCh:     make(chan bool),
+		lifecycle:  newLifecycle(),
+		counter:    &compressionCounter{},
 	}
 
-	// shutdown goroutine when connections is cleaned up
-	runtime.SetFinalizer(connection, finalizer)
 	transport, ok := connection.httpClient.Transport.(*oauth2.Transport)
 	if ok {
 		connection.startRefreshGoroutine(transport)
@@ -54,6 +60,14 @@ func NewConnection(scheme string, host string, httpClient *http.Client, headers
 	return connection
 }
 
+// Close cancels all requests in flight, stops the token refresh goroutine and closes
+// idle http connections. Every request made afterwards fails with fault.ErrClientClosed.
+func (con *Connection) Close() {
+	if con.lifecycle.close() {
+		con.httpClient.CloseIdleConnections()
+	}
+}
+
 // WaitForWeaviate waits until weaviate is started up and ready
 func (con *Connection) WaitForWeaviate(startupTimeout time.Duration) error {
 	if startupTimeout < 0 {
@@ -92,7 +106,7 @@ func (con *Connection) WaitForWeaviate(startupTimeout time.Duration) error {
 			return nil
 		}
 		if t.After(startTime.Add(startupTimeout)) {
-			return fmt.Errorf("weaviate did not start up in %s. Either the Weaviate URL %q is wrong or Weaviate did not start up in the interval given in 'startupTimeout'", startupTimeout.String(), con.basePath)
+			return fmt.Errorf("weaviate did not start up in %s. Either the Weaviate URL %q is wrong or Weaviate did not start up in the interval given in 'startupTimeout'", startupTimeout.String(), con.nodes.nodes[0].address)
 		}
 		log.Printf("Weaviate not yet up. Waiting for another second.")
 	}
@@ -122,28 +136,31 @@ func (con *Connection) startRefreshGoroutine(transport *oauth2.Transport) {
 	go func() {
 		// initial sleep before requesting a token
 		timeToSleep := time.Until(token.Expiry) - time.Second*10
-		if timeToSleep > 0 {
-			time.Sleep(timeToSleep)
-		}
 		for {
+			if timeToSleep > 0 {
+				timer := time.NewTimer(timeToSleep)
+				select {
+				case <-con.lifecycle.done:
+					timer.Stop()
+					return
+				case <-timer.C:
+				}
+			}
 			select {
-			case <-con.doneCh:
+			case <-con.lifecycle.done:
 				return
 			default:
-				token, err = transport.Source.Token()
-				if token == nil || time.Until(token.Expiry) < 0 {
-					log.Printf("Requested token is expired. Stop requesting new access token.")
-					return
-				}
-				if err != nil {
-					log.Printf("Error during token refresh, getting token: %v", err)
-					time.Sleep(time.Second)
-				} else {
-					timeToSleep = time.Until(token.Expiry) - time.Second*10
-					if timeToSleep > 0 {
-						time.Sleep(timeToSleep)
-					}
-				}
+			}
+			token, err = transport.Source.Token()
+			if token == nil || time.Until(token.Expiry) < 0 {
+				log.Printf("Requested token is expired. Stop requesting new access token.")
+				return
+			}
+			if err != nil {
+				log.Printf("Error during token refresh, getting token: %v", err)
+				timeToSleep = time.Second
+			} else {
+				timeToSleep = time.Until(token.Expiry) - time.Second*10
 			}
 		}
 	}()
@@ -168,25 +185,99 @@ func (con *Connection) marshalBody(body interface{}) (io.Reader, error) {
 	return bytes.NewBuffer(jsonBody), nil
 }
 
-func (con *Connection) createRequest(ctx context.Context, path string,
+// restBasePath returns the base path of the REST API of a host
+func restBasePath(scheme string) func(host string) string {
+	return func(host string) string {
+		return scheme + "://" + host + "/" + apiVersion
+	}
+}
+
+// SetNode registers a weaviate node under its name and marks it as healthy or unhealthy.
+// Unhealthy nodes do not receive requests until they are ejected.
+func (con *Connection) SetNode(name, host string, healthy bool) {
+	con.nodes.setNode(name, host, healthy)
+}
+
+// SetCompression configures gzip compression of request and response bodies
+func (con *Connection) SetCompression(config CompressionConfig) {
+	if config.MinRequestSize <= 0 {
+		config.MinRequestSize = defaultMinCompressionSize
+	}
+	con.compression = config
+}
+
+// CompressionStats returns the number of bytes sent and received before and after compression
+func (con *Connection) CompressionStats() CompressionStats {
+	return con.counter.stats()
+}
+
+func (con *Connection) createRequest(ctx context.Context, basePath string, path string,
 	restMethod string, body interface{},
 ) (*http.Request, error) {
-	url := con.basePath + path // Create the URL
+	url := basePath + path // Create the URL
 
 	jsonBody, err := con.marshalBody(body)
 	if err != nil {
 		return nil, err
 	}
+	compressed := false
+	if jsonBody != nil && con.compression.Requests {
+		jsonBody, compressed, err = con.compressBody(jsonBody)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	request, err := http.NewRequest(restMethod, url, jsonBody)
 	if err != nil {
 		return nil, err
 	}
 	con.addHeaderToRequest(request)
+	if compressed {
+		request.Header.Set("Content-Encoding", "gzip")
+	}
+	if con.compression.Responses {
+		// setting the header explicitly disables the transparent decompression of the http transport
+		request.Header.Set("Accept-Encoding", "gzip")
+	}
 	request = request.WithContext(ctx)
 	return request, nil
 }
 
+func (con *Connection) compressBody(body io.Reader) (io.Reader, bool, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(data) < con.compression.MinRequestSize {
+		return bytes.NewBuffer(data), false, nil
+	}
+	compressed, err := gzipCompress(data)
+	if err != nil {
+		return nil, false, err
+	}
+	con.counter.countRequest(len(data), len(compressed))
+	return bytes.NewBuffer(compressed), true, nil
+}
+
+// responseBody returns the body of the response, decompressing it if needed.
+// The returned func has to be called once the body has been consumed.
+func (con *Connection) responseBody(response *http.Response) (io.Reader, func(), error) {
+	if !con.compression.Responses || response.Header.Get("Content-Encoding") != "gzip" {
+		return response.Body, func() {}, nil
+	}
+	compressed := &countingReader{reader: response.Body}
+	gzipReader, err := gzip.NewReader(compressed)
+	if err != nil {
+		return nil, nil, err
+	}
+	decompressed := &countingReader{reader: gzipReader}
+	return decompressed, func() {
+		gzipReader.Close()
+		con.counter.countResponse(decompressed.n, compressed.n)
+	}, nil
+}
+
 // RunREST executes a http request
 // path: expects a resource path e.g. `/schema/things`
 // restMethod: as they are defined in constants in the *http* package
@@ -197,28 +288,66 @@ func (con *Connection) createRequest(ctx context.Context, path string,
 func (con *Connection) RunREST(ctx context.Context, path string,
 	restMethod string, requestBody interface{},
 ) (*ResponseData, error) {
-	request, requestErr := con.createRequest(ctx, path, restMethod, requestBody)
+	var responseData *ResponseData
+	err := con.RunRESTStream(ctx, path, restMethod, requestBody, func(statusCode int, body io.Reader) error {
+		data, bodyErr := io.ReadAll(body)
+		if bodyErr != nil {
+			return bodyErr
+		}
+		responseData = &ResponseData{
+			Body:       data,
+			StatusCode: statusCode,
+			Method:     restMethod,
+			Path:       path,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return responseData, nil
+}
+
+// RunRESTStream executes a http request like RunREST, but instead of reading the whole
+// response into memory it hands the response body to handle. The body is closed once handle returns.
+// Returns the error of handle or an error if there was a network issue
+func (con *Connection) RunRESTStream(ctx context.Context, path string,
+	restMethod string, requestBody interface{}, handle func(statusCode int, body io.Reader) error,
+) error {
+	ctx, done, err := con.lifecycle.begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
+	node := con.nodes.pick(path)
+	request, requestErr := con.createRequest(ctx, node.address, path, restMethod, requestBody)
 	if requestErr != nil {
-		return nil, requestErr
+		return requestErr
 	}
+	start := time.Now()
 	response, responseErr := con.httpClient.Do(request)
 	if responseErr != nil {
-		return nil, responseErr
+		// a cancelled or expired context is not the fault of the node
+		con.nodes.report(node, time.Since(start), ctx.Err() == nil)
+		return responseErr
 	}
+	con.nodes.report(node, time.Since(start), isNodeFailure(response.StatusCode))
 
 	defer response.Body.Close()
-	body, bodyErr := io.ReadAll(response.Body)
+	body, closeBody, bodyErr := con.responseBody(response)
 	if bodyErr != nil {
-		return nil, bodyErr
+		return bodyErr
 	}
-
-	return &ResponseData{
-		Body:       body,
-		StatusCode: response.StatusCode,
-	}, nil
+	defer closeBody()
+	return handle(response.StatusCode, body)
 }
 
 func (con *Connection) RunRESTExternal(ctx context.Context, hostAndPath string, restMethod string, requestBody interface{}) (*ResponseData, error) {
+	ctx, done, err := con.lifecycle.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
 	jsonBody, err := con.marshalBody(requestBody)
 	if err != nil {
 		return nil, err
@@ -244,13 +373,25 @@ func (con *Connection) RunRESTExternal(ctx context.Context, hostAndPath string,
 	return &ResponseData{
 		Body:       body,
 		StatusCode: response.StatusCode,
+		Method:     restMethod,
+		Path:       hostAndPath,
 	}, nil
 }
 
+func isNodeFailure(statusCode int) bool {
+	return statusCode == http.StatusBadGateway ||
+		statusCode == http.StatusServiceUnavailable ||
+		statusCode == http.StatusGatewayTimeout
+}
+
 // ResponseData encapsulation of the http request body and status
 type ResponseData struct {
 	Body       []byte
 	StatusCode int
+	// Method of the http request
+	Method string
+	// Path of the http request
+	Path string
 }
 
 // DecodeBodyIntoTarget unmarshall body into target var
@@ -0,0 +1,116 @@
+package connection
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/fault"
+)
+
+func TestConnection_Close(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/slow" {
+			close(started)
+			select {
+			case <-r.Context().Done():
+			case <-release:
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	con := NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, nil)
+	_, err := con.RunREST(context.Background(), "/meta", http.MethodGet, nil)
+	require.NoError(t, err)
+
+	inFlightErr := make(chan error)
+	go func() {
+		_, err := con.RunREST(context.Background(), "/slow", http.MethodGet, nil)
+		inFlightErr <- err
+	}()
+	<-started
+	con.Close()
+
+	err = <-inFlightErr
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	_, err = con.RunREST(context.Background(), "/meta", http.MethodGet, nil)
+	assert.Equal(t, fault.ErrClientClosed, err)
+
+	// closing twice is a no-op
+	con.Close()
+}
+
+func TestConnection_Compression(t *testing.T) {
+	largeText := strings.Repeat("weaviate ", 1000)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reader io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gzipReader, err := gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			reader = gzipReader
+		}
+		body, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		if r.Header.Get("Accept-Encoding") == "gzip" {
+			compressed, err := gzipCompress(body)
+			require.NoError(t, err)
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Write(compressed)
+			return
+		}
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	body := map[string]string{"text": largeText}
+
+	t.Run("disabled", func(t *testing.T) {
+		con := NewConnection("http", host, nil, nil)
+		response, err := con.RunREST(context.Background(), "/echo", http.MethodPost, body)
+		require.NoError(t, err)
+		var echo map[string]string
+		require.NoError(t, response.DecodeBodyIntoTarget(&echo))
+		assert.Equal(t, body, echo)
+		assert.Equal(t, CompressionStats{}, con.CompressionStats())
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		con := NewConnection("http", host, nil, nil)
+		con.SetCompression(CompressionConfig{Requests: true, Responses: true})
+		response, err := con.RunREST(context.Background(), "/echo", http.MethodPost, body)
+		require.NoError(t, err)
+		var echo map[string]string
+		require.NoError(t, response.DecodeBodyIntoTarget(&echo))
+		assert.Equal(t, body, echo)
+
+		stats := con.CompressionStats()
+		assert.Equal(t, int64(len(response.Body)), stats.RequestBytes)
+		assert.Equal(t, int64(len(response.Body)), stats.ResponseBytes)
+		assert.Less(t, stats.CompressedRequestBytes, stats.RequestBytes)
+		assert.Less(t, stats.CompressedResponseBytes, stats.ResponseBytes)
+		assert.Greater(t, stats.BytesSaved(), int64(0))
+	})
+
+	t.Run("small bodies are not compressed", func(t *testing.T) {
+		con := NewConnection("http", host, nil, nil)
+		con.SetCompression(CompressionConfig{Requests: true})
+		_, err := con.RunREST(context.Background(), "/echo", http.MethodPost, map[string]string{"text": "small"})
+		require.NoError(t, err)
+		assert.Equal(t, CompressionStats{}, con.CompressionStats())
+	})
+}
@@ -0,0 +1,89 @@
+package connection
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// JSONStream decodes a JSON document incrementally, so that large responses
+// can be processed without holding them in memory at once
+type JSONStream struct {
+	*json.Decoder
+}
+
+// NewJSONStream reading the JSON document from reader
+func NewJSONStream(reader io.Reader) *JSONStream {
+	return &JSONStream{json.NewDecoder(reader)}
+}
+
+// Object consumes the next value, which has to be a JSON object or null.
+// field is called with every key of the object and has to consume its value,
+// e.g. with Decode, Object, Array or Skip.
+func (s *JSONStream) Object(field func(key string) error) error {
+	isNull, err := s.open('{')
+	if err != nil || isNull {
+		return err
+	}
+	for s.More() {
+		token, err := s.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("expected object key, got %v", token)
+		}
+		if err := field(key); err != nil {
+			return err
+		}
+	}
+	return s.close('}')
+}
+
+// Array consumes the next value, which has to be a JSON array or null.
+// element is called for every element of the array and has to consume it,
+// e.g. with Decode, Object, Array or Skip.
+func (s *JSONStream) Array(element func() error) error {
+	isNull, err := s.open('[')
+	if err != nil || isNull {
+		return err
+	}
+	for s.More() {
+		if err := element(); err != nil {
+			return err
+		}
+	}
+	return s.close(']')
+}
+
+// Skip consumes the next value
+func (s *JSONStream) Skip() error {
+	var value json.RawMessage
+	return s.Decode(&value)
+}
+
+func (s *JSONStream) open(delim json.Delim) (bool, error) {
+	token, err := s.Token()
+	if err != nil {
+		return false, err
+	}
+	if token == nil {
+		return true, nil
+	}
+	if token != delim {
+		return false, fmt.Errorf("expected %v, got %v", delim, token)
+	}
+	return false, nil
+}
+
+func (s *JSONStream) close(delim json.Delim) error {
+	token, err := s.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v, got %v", delim, token)
+	}
+	return nil
+}
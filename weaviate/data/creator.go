@@ -15,6 +15,8 @@ import (
 // ObjectWrapper wrapping the result of a creation for both actions and things
 type ObjectWrapper struct {
 	Object *models.Object
+	// Vectors are the named vectors of the object, if its class has any
+	Vectors Vectors
 }
 
 // Creator builder to create a data object in weaviate
@@ -23,6 +25,7 @@ type Creator struct {
 	className        string
 	uuid             string
 	vector           []float32
+	vectors          Vectors
 	propertySchema   models.PropertySchema
 	consistencyLevel string
 	tenant           string
@@ -52,6 +55,12 @@ func (creator *Creator) WithVector(vector []float32) *Creator {
 	return creator
 }
 
+// WithVectors of the data object by name, for classes with multiple named vectors
+func (creator *Creator) WithVectors(vectors Vectors) *Creator {
+	creator.vectors = vectors
+	return creator
+}
+
 // WithConsistencyLevel determines how many replicas must acknowledge a request
 // before it is considered successful. Mutually exclusive with node_name param.
 // Can be one of 'ALL', 'ONE', or 'QUORUM'.
@@ -73,16 +82,17 @@ func (creator *Creator) Do(ctx context.Context) (*ObjectWrapper, error) {
 	object, _ := creator.PayloadObject()
 
 	path := creator.buildPath()
-	responseData, err = creator.connection.RunREST(ctx, path, http.MethodPost, object)
+	responseData, err = creator.connection.RunREST(ctx, path, http.MethodPost, payload(object, creator.vectors))
 	respErr := except.CheckResponseDataErrorAndStatusCode(responseData, err, 200)
 	if respErr != nil {
 		return nil, respErr
 	}
 
-	var resultObject models.Object
+	resultObject := ObjectWithVectors{Object: &models.Object{}}
 	parseErr := responseData.DecodeBodyIntoTarget(&resultObject)
 	return &ObjectWrapper{
-		Object: &resultObject,
+		Object:  resultObject.Object,
+		Vectors: resultObject.Vectors,
 	}, parseErr
 }
 
@@ -101,7 +111,8 @@ func (creator *Creator) buildPath() string {
 	return path
 }
 
-// PayloadObject returns the data object payload which may be used in a batch request
+// PayloadObject returns the data object payload which may be used in a batch request.
+// Named vectors are not part of it, use PayloadObjectWithVectors for them.
 func (creator *Creator) PayloadObject() (*models.Object, error) {
 	object := models.Object{
 		Class:      creator.className,
@@ -114,3 +125,13 @@ func (creator *Creator) PayloadObject() (*models.Object, error) {
 	}
 	return &object, nil
 }
+
+// PayloadObjectWithVectors returns the data object payload with its named vectors, which may
+// be used in a batch request
+func (creator *Creator) PayloadObjectWithVectors() (*ObjectWithVectors, error) {
+	object, err := creator.PayloadObject()
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectWithVectors{Object: object, Vectors: creator.vectors}, nil
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -10,6 +11,7 @@ import (
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/db"
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/fault"
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/pathbuilder"
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -52,7 +54,8 @@ func (getter *ObjectsGetter) WithClassName(className string) *ObjectsGetter {
 	return getter
 }
 
-// WithVector include the raw vector of the data object
+// WithVector include the raw vector of the data object. Named vectors are included as
+// well, but only decoded by DoWithVectors.
 func (getter *ObjectsGetter) WithVector() *ObjectsGetter {
 	getter.additionalProperties = append(getter.additionalProperties, "vector")
 	return getter
@@ -121,6 +124,85 @@ func (getter *ObjectsGetter) Do(ctx context.Context) ([]*models.Object, error) {
 	return []*models.Object{&object}, decodeErr
 }
 
+// DoWithVectors gets the data objects like Do together with their named vectors, which
+// are requested with WithVector
+func (getter *ObjectsGetter) DoWithVectors(ctx context.Context) ([]*ObjectWithVectors, error) {
+	responseData, err := getter.objectList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if responseData.StatusCode != 200 {
+		return nil, except.NewUnexpectedStatusCodeErrorFromRESTResponse(responseData)
+	}
+
+	if getter.id == "" {
+		var objects struct {
+			Objects []*ObjectWithVectors `json:"objects"`
+		}
+		decodeErr := responseData.DecodeBodyIntoTarget(&objects)
+		return objects.Objects, decodeErr
+	}
+
+	object := ObjectWithVectors{Object: &models.Object{}}
+	decodeErr := responseData.DecodeBodyIntoTarget(&object)
+	return []*ObjectWithVectors{&object}, decodeErr
+}
+
+// DoStream gets the data objects like Do, but decodes them one by one from the response
+// and hands each of them to handle, so that memory stays bounded regardless of the
+// number of objects. An error returned by handle stops the decoding and is returned as is.
+func (getter *ObjectsGetter) DoStream(ctx context.Context, handle func(object *models.Object) error) error {
+	var handleErr error
+	decodeAndHandle := func(stream *connection.JSONStream) error {
+		var object models.Object
+		if err := stream.Decode(&object); err != nil {
+			return err
+		}
+		handleErr = handle(&object)
+		return handleErr
+	}
+
+	err := getter.connection.RunRESTStream(ctx, getter.buildPath(), http.MethodGet, nil,
+		func(statusCode int, body io.Reader) error {
+			if statusCode != 200 {
+				responseBody, err := io.ReadAll(body)
+				if err != nil {
+					return err
+				}
+				return except.NewUnexpectedStatusCodeErrorFromRESTResponse(&connection.ResponseData{
+					StatusCode: statusCode,
+					Body:       responseBody,
+					Method:     http.MethodGet,
+					Path:       getter.buildPath(),
+				})
+			}
+
+			stream := connection.NewJSONStream(body)
+			if getter.id != "" {
+				return decodeAndHandle(stream)
+			}
+			return stream.Object(func(key string) error {
+				if key != "objects" {
+					return stream.Skip()
+				}
+				return stream.Array(func() error {
+					return decodeAndHandle(stream)
+				})
+			})
+		})
+	if handleErr != nil {
+		return handleErr
+	}
+	if err != nil {
+		if clientErr, ok := err.(*fault.WeaviateClientError); ok {
+			return clientErr
+		}
+		return except.NewDerivedWeaviateClientError(err)
+	}
+	return nil
+}
+
 func (getter *ObjectsGetter) objectList(ctx context.Context) (*connection.ResponseData, error) {
 	responseData, err := getter.connection.RunREST(ctx, getter.buildPath(), http.MethodGet, nil)
 	if err != nil {
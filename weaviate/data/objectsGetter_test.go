@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/fault"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestObjectsGetter_DoStream(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/objects", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"deprecations":null,"objects":[` +
+			`{"class":"Pizza","id":"abefd256-8574-442b-9293-9205193737ee","properties":{"name":"Hawaii"}},` +
+			`{"class":"Pizza","id":"5b6a08ba-1d46-43aa-89cc-8b070790c6f2","properties":{"name":"Doener"}}` +
+			`],"totalResults":2}`))
+	})
+	mux.HandleFunc("/v1/objects/Pizza/abefd256-8574-442b-9293-9205193737ee", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"class":"Pizza","id":"abefd256-8574-442b-9293-9205193737ee","properties":{"name":"Hawaii"}}`))
+	})
+	mux.HandleFunc("/v1/objects/Pizza/565da3b6-60b3-40e5-ba21-e6bfe5dbba91", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	newGetter := func() *ObjectsGetter {
+		getter := newTestGetter("1.14.0")
+		getter.connection = connection.NewConnection("http", strings.TrimPrefix(s.URL, "http://"), nil, nil)
+		return getter
+	}
+
+	t.Run("list of objects", func(t *testing.T) {
+		var objects []*models.Object
+		err := newGetter().DoStream(context.Background(), func(object *models.Object) error {
+			objects = append(objects, object)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		assert.Equal(t, "abefd256-8574-442b-9293-9205193737ee", objects[0].ID.String())
+		assert.Equal(t, "Doener", objects[1].Properties.(map[string]interface{})["name"])
+	})
+
+	t.Run("single object", func(t *testing.T) {
+		var objects []*models.Object
+		err := newGetter().WithClassName("Pizza").WithID("abefd256-8574-442b-9293-9205193737ee").
+			DoStream(context.Background(), func(object *models.Object) error {
+				objects = append(objects, object)
+				return nil
+			})
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Equal(t, "Pizza", objects[0].Class)
+	})
+
+	t.Run("handler error stops decoding", func(t *testing.T) {
+		stop := errors.New("stop")
+		calls := 0
+		err := newGetter().DoStream(context.Background(), func(object *models.Object) error {
+			calls++
+			return stop
+		})
+		assert.Equal(t, stop, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("unexpected status code", func(t *testing.T) {
+		err := newGetter().WithClassName("Pizza").WithID("565da3b6-60b3-40e5-ba21-e6bfe5dbba91").
+			DoStream(context.Background(), func(object *models.Object) error {
+				return nil
+			})
+		require.Error(t, err)
+		var clientErr *fault.WeaviateClientError
+		require.True(t, errors.As(err, &clientErr))
+		assert.Equal(t, http.StatusNotFound, clientErr.StatusCode)
+	})
+}
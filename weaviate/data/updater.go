@@ -18,6 +18,8 @@ type Updater struct {
 	id               string
 	className        string
 	propertySchema   models.PropertySchema
+	vector           []float32
+	vectors          Vectors
 	withMerge        bool
 	consistencyLevel string
 	tenant           string
@@ -42,6 +44,18 @@ func (updater *Updater) WithProperties(propertySchema models.PropertySchema) *Up
 	return updater
 }
 
+// WithVector replaces the vector of the data object
+func (updater *Updater) WithVector(vector []float32) *Updater {
+	updater.vector = vector
+	return updater
+}
+
+// WithVectors replaces the named vectors of the data object, for classes with multiple named vectors
+func (updater *Updater) WithVectors(vectors Vectors) *Updater {
+	updater.vectors = vectors
+	return updater
+}
+
 // WithMerge indicates that the object should be merged with the existing object instead of replacing it
 func (updater *Updater) WithMerge() *Updater {
 	updater.withMerge = true
@@ -85,7 +99,8 @@ func (updater *Updater) runUpdate(ctx context.Context, path string, httpMethod s
 		Class:      updater.className,
 		ID:         strfmt.UUID(updater.id),
 		Properties: updater.propertySchema,
+		Vector:     updater.vector,
 		Tenant:     updater.tenant,
 	}
-	return updater.connection.RunREST(ctx, path, httpMethod, object)
+	return updater.connection.RunREST(ctx, path, httpMethod, payload(&object, updater.vectors))
 }
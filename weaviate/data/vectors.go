@@ -0,0 +1,23 @@
+package data
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// Vectors of an object by the names of the vectors configured for its class
+type Vectors map[string][]float32
+
+// ObjectWithVectors is a data object with its named vectors. The object model of the
+// weaviate version this client is built against has no field for named vectors, so they
+// are encoded next to the fields of the object.
+type ObjectWithVectors struct {
+	*models.Object
+	Vectors Vectors `json:"vectors,omitempty"`
+}
+
+// payload returns the object itself if there are no named vectors, so that requests stay
+// unchanged for classes with a single vector
+func payload(object *models.Object, vectors Vectors) interface{} {
+	if len(vectors) == 0 {
+		return object
+	}
+	return &ObjectWithVectors{Object: object, Vectors: vectors}
+}
@@ -0,0 +1,88 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
+)
+
+func TestNamedVectors(t *testing.T) {
+	var body map[string]interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/objects", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body = nil
+			raw, _ := io.ReadAll(r.Body)
+			require.NoError(t, json.Unmarshal(raw, &body))
+			w.Write(raw)
+			return
+		}
+		w.Write([]byte(`{"objects":[` +
+			`{"class":"Pizza","id":"abefd256-8574-442b-9293-9205193737ee","vectors":{"name":[0.1,0.2],"description":[0.3]}},` +
+			`{"class":"Pizza","id":"5b6a08ba-1d46-43aa-89cc-8b070790c6f2","vector":[0.4]}` +
+			`],"totalResults":2}`))
+	})
+	mux.HandleFunc("/v1/objects/Pizza/abefd256-8574-442b-9293-9205193737ee", func(w http.ResponseWriter, r *http.Request) {
+		body = nil
+		raw, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(raw, &body))
+		w.WriteHeader(http.StatusOK)
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+	con := connection.NewConnection("http", strings.TrimPrefix(s.URL, "http://"), nil, nil)
+
+	t.Run("create with named vectors", func(t *testing.T) {
+		creator := &Creator{connection: con}
+		result, err := creator.WithClassName("Pizza").
+			WithVectors(Vectors{"name": {0.1, 0.2}}).
+			Do(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]interface{}{"name": []interface{}{0.1, 0.2}}, body["vectors"])
+		assert.Equal(t, "Pizza", body["class"])
+		assert.Equal(t, "Pizza", result.Object.Class)
+		assert.Equal(t, Vectors{"name": {0.1, 0.2}}, result.Vectors)
+	})
+
+	t.Run("create without named vectors", func(t *testing.T) {
+		creator := &Creator{connection: con}
+		result, err := creator.WithClassName("Pizza").WithVector([]float32{0.5}).Do(context.Background())
+		require.NoError(t, err)
+
+		assert.NotContains(t, body, "vectors")
+		assert.Equal(t, []interface{}{0.5}, body["vector"])
+		assert.Nil(t, result.Vectors)
+	})
+
+	t.Run("update with named vectors", func(t *testing.T) {
+		updater := &Updater{connection: con, dbVersionSupport: newTestGetter("1.22.0").dbVersionSupport}
+		err := updater.WithClassName("Pizza").WithID("abefd256-8574-442b-9293-9205193737ee").
+			WithVectors(Vectors{"description": {0.3}}).
+			Do(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]interface{}{"description": []interface{}{0.3}}, body["vectors"])
+	})
+
+	t.Run("get with named vectors", func(t *testing.T) {
+		getter := newTestGetter("1.22.0")
+		getter.connection = con
+		objects, err := getter.WithVector().DoWithVectors(context.Background())
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		assert.Equal(t, "abefd256-8574-442b-9293-9205193737ee", objects[0].ID.String())
+		assert.Equal(t, Vectors{"name": {0.1, 0.2}, "description": {0.3}}, objects[0].Vectors)
+		assert.Nil(t, objects[1].Vectors)
+		assert.Len(t, objects[1].Vector, 1)
+	})
+}
@@ -1,10 +1,12 @@
 package except
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/fault"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
 // NewWeaviateClientError from status code and error message
@@ -26,9 +28,39 @@ func NewDerivedWeaviateClientError(err error) *fault.WeaviateClientError {
 	}
 }
 
-// NewUnexpectedStatusCodeErrorFromRESTResponse creates the error based on a response data object
+// NewUnexpectedStatusCodeErrorFromRESTResponse creates the error based on a response data object.
+// The messages of the error response are parsed if the body contains one.
 func NewUnexpectedStatusCodeErrorFromRESTResponse(responseData *connection.ResponseData) *fault.WeaviateClientError {
-	return NewWeaviateClientError(responseData.StatusCode, string(responseData.Body))
+	return &fault.WeaviateClientError{
+		IsUnexpectedStatusCode: true,
+		StatusCode:             responseData.StatusCode,
+		Msg:                    string(responseData.Body),
+		Messages:               parseErrorMessages(responseData.Body),
+		Method:                 responseData.Method,
+		Path:                   responseData.Path,
+	}
+}
+
+// parseErrorMessages from an error response of weaviate. Besides models.ErrorResponse
+// weaviate answers with a single message object if the request failed validation.
+func parseErrorMessages(body []byte) []string {
+	var errorResponse models.ErrorResponse
+	if err := json.Unmarshal(body, &errorResponse); err == nil && len(errorResponse.Error) > 0 {
+		messages := make([]string, 0, len(errorResponse.Error))
+		for _, item := range errorResponse.Error {
+			if item != nil {
+				messages = append(messages, item.Message)
+			}
+		}
+		return messages
+	}
+	var validationError struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &validationError); err == nil && validationError.Message != "" {
+		return []string{validationError.Message}
+	}
+	return nil
 }
 
 // CheckResponseDataErrorAndStatusCode returns the response error if it is not nil,
@@ -63,3 +63,42 @@ func TestNewWeaviateClientError(t *testing.T) {
 		})
 	}
 }
+
+func TestNewUnexpectedStatusCodeErrorFromRESTResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		messages []string
+	}{
+		{
+			name:     "error response",
+			body:     `{"error":[{"message":"class already exists"},{"message":"second"}]}`,
+			messages: []string{"class already exists", "second"},
+		},
+		{
+			name:     "validation error",
+			body:     `{"code":606,"message":"tokenization in body should be one of [word lowercase whitespace field]"}`,
+			messages: []string{"tokenization in body should be one of [word lowercase whitespace field]"},
+		},
+		{
+			name:     "no json",
+			body:     "service unavailable",
+			messages: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewUnexpectedStatusCodeErrorFromRESTResponse(&connection.ResponseData{
+				StatusCode: 422,
+				Body:       []byte(tt.body),
+				Method:     "POST",
+				Path:       "/schema",
+			})
+			assert.Equal(t, tt.messages, err.Messages)
+			assert.Equal(t, "POST", err.Method)
+			assert.Equal(t, "/schema", err.Path)
+			assert.Equal(t, tt.body, err.Msg)
+			assert.True(t, err.IsUnexpectedStatusCode)
+		})
+	}
+}
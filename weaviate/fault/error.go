@@ -1,7 +1,34 @@
 package fault
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrClientClosed is returned for every call made after the client has been closed
+	ErrClientClosed = errors.New("weaviate client closed")
+	// ErrNotFound matches errors caused by a 404 Not Found response
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists matches errors caused by a 409 Conflict response or a 422 response reporting
+	// an already existing entity
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrUnauthorized matches errors caused by a 401 Unauthorized response
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden matches errors caused by a 403 Forbidden response
+	ErrForbidden = errors.New("forbidden")
+	// ErrUnprocessable matches errors caused by a 422 Unprocessable Entity response
+	ErrUnprocessable = errors.New("unprocessable entity")
+	// ErrRateLimited matches errors caused by a 429 Too Many Requests response
+	ErrRateLimited = errors.New("rate limited")
+	// ErrUnavailable matches errors caused by a 502, 503 or 504 response
+	ErrUnavailable = errors.New("unavailable")
+	// ErrTimeout matches errors caused by an expired context or a network timeout
+	ErrTimeout = errors.New("timeout")
 )
 
 // WeaviateClientError is returned if the client experienced an error.
@@ -9,11 +36,20 @@ import (
 //	If the error is due to weaviate returning an unexpected status code the IsUnexpectedStatusCode field will be true
 //	 and the StatusCode field will be set
 //	If the error occurred for another reason the DerivedFromError will be set and IsUnexpectedStatusCode will be false
+//
+// The kind of the error can be checked with errors.Is and the sentinel errors of this package,
+// e.g. errors.Is(err, fault.ErrNotFound). The DerivedFromError is unwrapped by errors.Is and errors.As.
 type WeaviateClientError struct {
 	IsUnexpectedStatusCode bool
 	StatusCode             int
 	Msg                    string
 	DerivedFromError       error
+	// Messages of the error response returned by weaviate, if it could be parsed
+	Messages []string
+	// Method of the failed http request
+	Method string
+	// Path of the failed http request
+	Path string
 }
 
 // Error message of the unexpected status code error
@@ -29,3 +65,48 @@ func (uce *WeaviateClientError) Error() string {
 func (uce *WeaviateClientError) GoString() string {
 	return uce.Error()
 }
+
+// Unwrap returns the error the client error was derived from
+func (uce *WeaviateClientError) Unwrap() error {
+	return uce.DerivedFromError
+}
+
+// Is reports whether the error is of the kind described by one of the sentinel errors of this package
+func (uce *WeaviateClientError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return uce.StatusCode == http.StatusNotFound
+	case ErrAlreadyExists:
+		return uce.StatusCode == http.StatusConflict ||
+			(uce.StatusCode == http.StatusUnprocessableEntity && uce.mentions("already exists"))
+	case ErrUnauthorized:
+		return uce.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return uce.StatusCode == http.StatusForbidden
+	case ErrUnprocessable:
+		return uce.StatusCode == http.StatusUnprocessableEntity
+	case ErrRateLimited:
+		return uce.StatusCode == http.StatusTooManyRequests
+	case ErrUnavailable:
+		return uce.StatusCode == http.StatusBadGateway ||
+			uce.StatusCode == http.StatusServiceUnavailable ||
+			uce.StatusCode == http.StatusGatewayTimeout
+	case ErrTimeout:
+		if errors.Is(uce.DerivedFromError, context.DeadlineExceeded) {
+			return true
+		}
+		var netErr net.Error
+		return errors.As(uce.DerivedFromError, &netErr) && netErr.Timeout()
+	default:
+		return false
+	}
+}
+
+func (uce *WeaviateClientError) mentions(text string) bool {
+	for _, msg := range uce.Messages {
+		if strings.Contains(msg, text) {
+			return true
+		}
+	}
+	return strings.Contains(uce.Msg, text)
+}
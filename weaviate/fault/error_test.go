@@ -1,10 +1,13 @@
 package fault
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
 func TestWeaviateClientError_Error(t *testing.T) {
@@ -53,3 +56,99 @@ func TestWeaviateClientError_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestWeaviateClientError_Is(t *testing.T) {
+	kinds := []error{
+		ErrNotFound, ErrAlreadyExists, ErrUnauthorized, ErrForbidden,
+		ErrUnprocessable, ErrRateLimited, ErrUnavailable, ErrTimeout,
+	}
+	tests := []struct {
+		name        string
+		weaviateErr error
+		want        []error
+	}{
+		{
+			name:        "not found",
+			weaviateErr: &WeaviateClientError{IsUnexpectedStatusCode: true, StatusCode: 404},
+			want:        []error{ErrNotFound},
+		},
+		{
+			name:        "conflict",
+			weaviateErr: &WeaviateClientError{IsUnexpectedStatusCode: true, StatusCode: 409},
+			want:        []error{ErrAlreadyExists},
+		},
+		{
+			name: "class already exists",
+			weaviateErr: &WeaviateClientError{
+				IsUnexpectedStatusCode: true,
+				StatusCode:             422,
+				Messages:               []string{"class name \"Pizza\" already exists"},
+			},
+			want: []error{ErrAlreadyExists, ErrUnprocessable},
+		},
+		{
+			name:        "unprocessable",
+			weaviateErr: &WeaviateClientError{IsUnexpectedStatusCode: true, StatusCode: 422},
+			want:        []error{ErrUnprocessable},
+		},
+		{
+			name:        "unauthorized",
+			weaviateErr: &WeaviateClientError{IsUnexpectedStatusCode: true, StatusCode: 401},
+			want:        []error{ErrUnauthorized},
+		},
+		{
+			name:        "forbidden",
+			weaviateErr: &WeaviateClientError{IsUnexpectedStatusCode: true, StatusCode: 403},
+			want:        []error{ErrForbidden},
+		},
+		{
+			name:        "rate limited",
+			weaviateErr: &WeaviateClientError{IsUnexpectedStatusCode: true, StatusCode: 429},
+			want:        []error{ErrRateLimited},
+		},
+		{
+			name:        "unavailable",
+			weaviateErr: &WeaviateClientError{IsUnexpectedStatusCode: true, StatusCode: 503},
+			want:        []error{ErrUnavailable},
+		},
+		{
+			name:        "timeout",
+			weaviateErr: &WeaviateClientError{StatusCode: -1, DerivedFromError: fmt.Errorf("post: %w", context.DeadlineExceeded)},
+			want:        []error{ErrTimeout},
+		},
+		{
+			name: "derived from not found",
+			weaviateErr: &WeaviateClientError{
+				StatusCode:       -1,
+				DerivedFromError: &WeaviateClientError{IsUnexpectedStatusCode: true, StatusCode: 404},
+			},
+			want: []error{ErrNotFound},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, kind := range kinds {
+				want := false
+				for _, w := range tt.want {
+					want = want || w == kind
+				}
+				assert.Equal(t, want, errors.Is(tt.weaviateErr, kind), kind.Error())
+			}
+		})
+	}
+}
+
+func TestWeaviateClientError_Unwrap(t *testing.T) {
+	weaviateErr := &WeaviateClientError{StatusCode: -1, DerivedFromError: context.Canceled}
+	assert.True(t, errors.Is(weaviateErr, context.Canceled))
+	assert.False(t, errors.Is(weaviateErr, context.DeadlineExceeded))
+}
+
+func TestGraphQLError(t *testing.T) {
+	gqlErr := &GraphQLError{Errors: []*models.GraphQLError{
+		{Message: "no such prop with name 'unknown'", Path: []string{"Get", "Pizza"}},
+		{Message: "invalid where filter"},
+	}}
+	assert.EqualError(t, gqlErr, "graphql errors: no such prop with name 'unknown', invalid where filter")
+	assert.Equal(t, []string{"no such prop with name 'unknown'", "invalid where filter"}, gqlErr.Messages())
+}
@@ -0,0 +1,29 @@
+package fault
+
+import (
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// GraphQLError is returned if weaviate answered a GraphQL query with errors
+type GraphQLError struct {
+	// Errors reported in the GraphQL response, including their locations and paths
+	Errors []*models.GraphQLError
+}
+
+// Error message joining the messages of all GraphQL errors
+func (gqle *GraphQLError) Error() string {
+	return "graphql errors: " + strings.Join(gqle.Messages(), ", ")
+}
+
+// Messages of all GraphQL errors
+func (gqle *GraphQLError) Messages() []string {
+	messages := make([]string, 0, len(gqle.Errors))
+	for _, gqlErr := range gqle.Errors {
+		if gqlErr != nil {
+			messages = append(messages, gqlErr.Message)
+		}
+	}
+	return messages
+}
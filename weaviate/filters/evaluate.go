@@ -0,0 +1,417 @@
+package filters
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const earthRadiusMeters = 6371000
+
+// Matches evaluates the filter against the properties of an object locally, without
+// sending a request to weaviate. Property values may be given as the Go types of the
+// properties or as they are decoded from JSON, e.g. float64 for int properties or
+// RFC3339 strings for dates. Array properties match if any of their elements matches.
+//
+// The evaluation follows the weaviate semantics with these known differences:
+//   - text values are compared as a whole and case-sensitive, like properties with
+//     field tokenization. weaviate tokenizes text properties with word, lowercase or
+//     whitespace tokenization and matches Equal and Like on the single tokens, so the
+//     server may return objects which don't match locally.
+//   - only paths to properties of the object itself are supported, cross-reference
+//     paths like ["inPublication", "Publication", "name"] return an error. A path
+//     to a reference property compared with an int value filters by the number of
+//     references.
+//   - empty arrays are treated as null, i.e. IsNull matches them.
+func (b *WhereBuilder) Matches(properties map[string]interface{}) (bool, error) {
+	return b.evaluate(func(property string) interface{} {
+		return properties[property]
+	})
+}
+
+// MatchesObject evaluates the filter against the object locally like Matches, additionally
+// resolving the _id, _creationTimeUnix and _lastUpdateTimeUnix metadata paths
+func (b *WhereBuilder) MatchesObject(object *models.Object) (bool, error) {
+	properties, _ := object.Properties.(map[string]interface{})
+	return b.evaluate(func(property string) interface{} {
+		switch property {
+		case idPath:
+			return object.ID.String()
+		case creationTimeUnixPath:
+			return object.CreationTimeUnix
+		case lastUpdateTimeUnixPath:
+			return object.LastUpdateTimeUnix
+		default:
+			return properties[property]
+		}
+	})
+}
+
+func (b *WhereBuilder) evaluate(lookup func(property string) interface{}) (bool, error) {
+	switch b.operator {
+	case And, Or:
+		if len(b.operands) == 0 {
+			return false, fmt.Errorf("operator %s requires operands", b.operator)
+		}
+		for _, operand := range b.operands {
+			matches, err := operand.evaluate(lookup)
+			if err != nil {
+				return false, err
+			}
+			if b.operator == And && !matches {
+				return false, nil
+			}
+			if b.operator == Or && matches {
+				return true, nil
+			}
+		}
+		return b.operator == And, nil
+	case Not:
+		if len(b.operands) != 1 {
+			return false, fmt.Errorf("operator %s requires exactly one operand", b.operator)
+		}
+		matches, err := b.operands[0].evaluate(lookup)
+		return !matches, err
+	}
+
+	if len(b.path) != 1 {
+		return false, fmt.Errorf("path %v is not supported, only paths to properties of the object can be evaluated", b.path)
+	}
+	property := b.path[0]
+	var value interface{}
+	if strings.HasPrefix(property, "len(") && strings.HasSuffix(property, ")") {
+		value = propertyLength(lookup(property[len("len(") : len(property)-1]))
+	} else {
+		value = lookup(property)
+		if refs, isRef := referenceCount(value); isRef && b.withValueInt {
+			value = refs
+		}
+	}
+	elements := elementsOf(value)
+
+	if b.operator == IsNull {
+		if !b.withValueBoolean || len(b.valueBoolean) != 1 {
+			return false, fmt.Errorf("operator %s requires a single boolean value", b.operator)
+		}
+		return (len(elements) == 0) == b.valueBoolean[0], nil
+	}
+
+	if b.operator == WithinGeoRange {
+		if b.valueGeoRange == nil {
+			return false, fmt.Errorf("operator %s requires a geo range value", b.operator)
+		}
+		return anyElement(elements, func(element interface{}) (bool, error) {
+			latitude, longitude, err := toGeoCoordinates(element)
+			if err != nil {
+				return false, err
+			}
+			distance := haversineDistance(float64(b.valueGeoRange.Latitude), float64(b.valueGeoRange.Longitude),
+				latitude, longitude)
+			return distance <= float64(b.valueGeoRange.MaxDistance), nil
+		})
+	}
+
+	values, compare, err := b.comparableValues(property)
+	if err != nil {
+		return false, err
+	}
+	if len(values) == 0 {
+		return false, fmt.Errorf("operator %s requires a value", b.operator)
+	}
+	equals := func(value interface{}) (bool, error) {
+		return anyElement(elements, func(element interface{}) (bool, error) {
+			c, err := compare(element, value)
+			return c == 0, err
+		})
+	}
+	compareWith := func(matches func(c int) bool) (bool, error) {
+		return anyElement(elements, func(element interface{}) (bool, error) {
+			c, err := compare(element, values[0])
+			return matches(c), err
+		})
+	}
+
+	switch b.operator {
+	case Equal:
+		return equals(values[0])
+	case NotEqual:
+		matches, err := equals(values[0])
+		return !matches, err
+	case GreaterThan:
+		return compareWith(func(c int) bool { return c > 0 })
+	case GreaterThanEqual:
+		return compareWith(func(c int) bool { return c >= 0 })
+	case LessThan:
+		return compareWith(func(c int) bool { return c < 0 })
+	case LessThanEqual:
+		return compareWith(func(c int) bool { return c <= 0 })
+	case Like:
+		pattern, ok := values[0].(string)
+		if !ok {
+			return false, fmt.Errorf("operator %s requires a text value", b.operator)
+		}
+		like := likePattern(pattern)
+		return anyElement(elements, func(element interface{}) (bool, error) {
+			text, ok := element.(string)
+			if !ok {
+				return false, fmt.Errorf("property %s: expected text, got %T", property, element)
+			}
+			return like.MatchString(text), nil
+		})
+	case ContainsAny:
+		for _, value := range values {
+			if matches, err := equals(value); err != nil || matches {
+				return matches, err
+			}
+		}
+		return false, nil
+	case ContainsAll:
+		for _, value := range values {
+			if matches, err := equals(value); err != nil || !matches {
+				return false, err
+			}
+		}
+		return true, nil
+	default:
+		return false, fmt.Errorf("operator %q is not supported", b.operator)
+	}
+}
+
+// comparableValues returns the filter values and a function comparing a property value
+// with one of them
+func (b *WhereBuilder) comparableValues(property string) ([]interface{}, func(element, value interface{}) (int, error), error) {
+	switch {
+	case b.withValueInt:
+		return toInterfaces(b.valueInt), func(element, value interface{}) (int, error) {
+			return compareNumbers(property, element, float64(value.(int64)))
+		}, nil
+	case b.withValueNumber:
+		return toInterfaces(b.valueNumber), func(element, value interface{}) (int, error) {
+			return compareNumbers(property, element, value.(float64))
+		}, nil
+	case b.withValueBoolean:
+		return toInterfaces(b.valueBoolean), func(element, value interface{}) (int, error) {
+			boolean, ok := element.(bool)
+			if !ok {
+				return 0, fmt.Errorf("property %s: expected boolean, got %T", property, element)
+			}
+			if boolean == value.(bool) {
+				return 0, nil
+			}
+			if boolean {
+				return 1, nil
+			}
+			return -1, nil
+		}, nil
+	case len(b.valueText) > 0 || len(b.valueString) > 0:
+		values := append(toInterfaces(b.valueText), toInterfaces(b.valueString)...)
+		return values, func(element, value interface{}) (int, error) {
+			if property == creationTimeUnixPath || property == lastUpdateTimeUnixPath {
+				timestamp, err := strconv.ParseInt(value.(string), 10, 64)
+				if err != nil {
+					return 0, fmt.Errorf("property %s: invalid timestamp %q", property, value)
+				}
+				if text, ok := element.(string); ok {
+					element = json.Number(text)
+				}
+				return compareNumbers(property, element, float64(timestamp))
+			}
+			text, ok := element.(string)
+			if !ok {
+				return 0, fmt.Errorf("property %s: expected text, got %T", property, element)
+			}
+			return strings.Compare(text, value.(string)), nil
+		}, nil
+	case b.withValueDate:
+		return toInterfaces(b.valueDate), func(element, value interface{}) (int, error) {
+			date, err := toTime(element)
+			if err != nil {
+				return 0, fmt.Errorf("property %s: %w", property, err)
+			}
+			switch other := value.(time.Time); {
+			case date.Before(other):
+				return -1, nil
+			case date.After(other):
+				return 1, nil
+			default:
+				return 0, nil
+			}
+		}, nil
+	default:
+		return nil, nil, fmt.Errorf("operator %s requires a value", b.operator)
+	}
+}
+
+func toInterfaces[T any](values []T) []interface{} {
+	result := make([]interface{}, len(values))
+	for i := range values {
+		result[i] = values[i]
+	}
+	return result
+}
+
+// elementsOf returns the elements of an array value, a single element for scalar
+// values and no elements for null
+func elementsOf(value interface{}) []interface{} {
+	if value == nil {
+		return nil
+	}
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case string, []byte, json.RawMessage:
+		return []interface{}{value}
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		elements := make([]interface{}, rv.Len())
+		for i := range elements {
+			elements[i] = rv.Index(i).Interface()
+		}
+		return elements
+	}
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+	return []interface{}{value}
+}
+
+func anyElement(elements []interface{}, matches func(element interface{}) (bool, error)) (bool, error) {
+	for _, element := range elements {
+		if ok, err := matches(element); err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// propertyLength returns the number of characters of a text or the number of elements of an array
+func propertyLength(value interface{}) int64 {
+	if text, ok := value.(string); ok {
+		return int64(utf8.RuneCountInString(text))
+	}
+	return int64(len(elementsOf(value)))
+}
+
+// referenceCount returns the number of references if value is a reference property
+func referenceCount(value interface{}) (int64, bool) {
+	switch refs := value.(type) {
+	case models.MultipleRef:
+		return int64(len(refs)), true
+	case []*models.SingleRef:
+		return int64(len(refs)), true
+	case []interface{}:
+		if len(refs) == 0 {
+			return 0, false
+		}
+		for _, ref := range refs {
+			object, ok := ref.(map[string]interface{})
+			if !ok {
+				return 0, false
+			}
+			if _, ok := object["beacon"]; !ok {
+				return 0, false
+			}
+		}
+		return int64(len(refs)), true
+	}
+	return 0, false
+}
+
+func compareNumbers(property string, element interface{}, value float64) (int, error) {
+	var number float64
+	switch n := element.(type) {
+	case int:
+		number = float64(n)
+	case int32:
+		number = float64(n)
+	case int64:
+		number = float64(n)
+	case float32:
+		number = float64(n)
+	case float64:
+		number = n
+	case json.Number:
+		f, err := n.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("property %s: %w", property, err)
+		}
+		number = f
+	default:
+		return 0, fmt.Errorf("property %s: expected number, got %T", property, element)
+	}
+	switch {
+	case number < value:
+		return -1, nil
+	case number > value:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+func toTime(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		return time.Parse(time.RFC3339Nano, v)
+	default:
+		return time.Time{}, fmt.Errorf("expected date, got %T", value)
+	}
+}
+
+func toGeoCoordinates(value interface{}) (float64, float64, error) {
+	switch v := value.(type) {
+	case *models.GeoCoordinates:
+		if v.Latitude != nil && v.Longitude != nil {
+			return float64(*v.Latitude), float64(*v.Longitude), nil
+		}
+	case models.GeoCoordinates:
+		return toGeoCoordinates(&v)
+	case map[string]interface{}:
+		latitude, latOk := v["latitude"].(float64)
+		longitude, lonOk := v["longitude"].(float64)
+		if latOk && lonOk {
+			return latitude, longitude, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("expected geo coordinates, got %v", value)
+}
+
+// haversineDistance in meters between two coordinates
+func haversineDistance(lat1, lon1, lat2, lon2 float64) float64 {
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+	dLat := toRadians(lat2 - lat1)
+	dLon := toRadians(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(a))
+}
+
+// likePattern converts a Like pattern, where ? matches exactly one and * any number
+// of characters, to a regular expression
+func likePattern(pattern string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.MustCompile("(?s)" + sb.String())
+}
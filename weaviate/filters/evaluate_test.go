@@ -0,0 +1,126 @@
+package filters
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestWhereBuilder_Matches(t *testing.T) {
+	var properties map[string]interface{}
+	// properties as they are decoded from a weaviate response
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "Hawaii",
+		"price": 12.5,
+		"size": 3,
+		"spicy": false,
+		"tags": ["italian", "vegan"],
+		"published": "2023-10-19T12:00:00Z",
+		"location": {"latitude": 52.3667, "longitude": 4.9},
+		"empty": [],
+		"hasToppings": [{"beacon": "weaviate://localhost/Topping/5b6a08ba-1d46-43aa-89cc-8b070790c6f2"}]
+	}`), &properties))
+	published := time.Date(2023, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		filter  *WhereBuilder
+		matches bool
+	}{
+		{"text equal", TextProp("name").Equal("Hawaii"), true},
+		{"text equal is case-sensitive", TextProp("name").Equal("hawaii"), false},
+		{"text not equal", TextProp("name").NotEqual("Doener"), true},
+		{"missing property not equal", TextProp("missing").NotEqual("Doener"), true},
+		{"missing property equal", TextProp("missing").Equal("Doener"), false},
+		{"string value", Where().WithOperator(Equal).WithPath([]string{"name"}).WithValueString("Hawaii"), true},
+		{"text greater than", TextProp("name").GreaterThan("Doener"), true},
+		{"like with star", TextProp("name").Like("Haw*"), true},
+		{"like with question mark", TextProp("name").Like("Hawai?"), true},
+		{"like no match", TextProp("name").Like("Haw?"), false},
+		{"like escapes regexp", TextProp("name").Like("Haw.ii"), false},
+		{"number less than", NumberProp("price").LessThan(20), true},
+		{"number greater than", NumberProp("price").GreaterThan(20), false},
+		{"int equal", IntProp("size").Equal(3), true},
+		{"int less than equal", IntProp("size").LessThanEqual(2), false},
+		{"boolean equal", BooleanProp("spicy").Equal(false), true},
+		{"date equal", DateProp("published").Equal(published), true},
+		{"date before", DateProp("published").LessThan(published.Add(-time.Hour)), false},
+		{"array equal matches any element", TextProp("tags").Equal("vegan"), true},
+		{"contains any", TextProp("tags").ContainsAny("meat", "vegan"), true},
+		{"contains any no match", TextProp("tags").ContainsAny("meat", "fish"), false},
+		{"contains all", TextProp("tags").ContainsAll("vegan", "italian"), true},
+		{"contains all missing one", TextProp("tags").ContainsAll("vegan", "meat"), false},
+		{"is null on missing property", PropertyIsNull("missing"), true},
+		{"is null on empty array", PropertyIsNull("empty"), true},
+		{"is not null", PropertyIsNotNull("name"), true},
+		{"property length", PropertyLength("name").Equal(6), true},
+		{"array length", PropertyLength("tags").GreaterThan(2), false},
+		{"missing property length", PropertyLength("missing").Equal(0), true},
+		{"reference count", ReferenceCount("hasToppings").Equal(1), true},
+		{"reference count greater than", ReferenceCount("hasToppings").GreaterThan(1), false},
+		{"within geo range", GeoProp("location").WithinGeoRange(52.3702, 4.8952, 1000), true},
+		{"outside geo range", GeoProp("location").WithinGeoRange(51.51, -0.09, 100000), false},
+		{"and", AllOf(TextProp("name").Equal("Hawaii"), NumberProp("price").LessThan(20)), true},
+		{"and with one mismatch", AllOf(TextProp("name").Equal("Hawaii"), NumberProp("price").LessThan(10)), false},
+		{"or", AnyOf(TextProp("name").Equal("Doener"), NumberProp("price").LessThan(20)), true},
+		{"not", Negate(TextProp("name").Equal("Hawaii")), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := tt.filter.Matches(properties)
+			require.NoError(t, err)
+			assert.Equal(t, tt.matches, matches)
+		})
+	}
+
+	errorTests := []struct {
+		name   string
+		filter *WhereBuilder
+	}{
+		{"cross-reference path", TextProp("inPublication", "Publication", "name").Equal("NYT")},
+		{"type mismatch", IntProp("name").Equal(3)},
+		{"missing value", Where().WithOperator(Equal).WithPath([]string{"name"})},
+		{"not without operands", Where().WithOperator(Not)},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.filter.Matches(properties)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestWhereBuilder_MatchesObject(t *testing.T) {
+	created := time.UnixMilli(1697716800000)
+	object := &models.Object{
+		Class:              "Pizza",
+		ID:                 "5b6a08ba-1d46-43aa-89cc-8b070790c6f2",
+		CreationTimeUnix:   created.UnixMilli(),
+		LastUpdateTimeUnix: created.Add(time.Hour).UnixMilli(),
+		Properties:         map[string]interface{}{"name": "Hawaii"},
+	}
+
+	tests := []struct {
+		name    string
+		filter  *WhereBuilder
+		matches bool
+	}{
+		{"id", ID().Equal("5b6a08ba-1d46-43aa-89cc-8b070790c6f2"), true},
+		{"other id", ID().Equal("00000000-0000-0000-0000-000000000000"), false},
+		{"creation time", CreationTime().Equal(created), true},
+		{"last update time range", LastUpdateTime().Between(created, created.Add(2*time.Hour)), true},
+		{"last update time before", LastUpdateTime().Before(created), false},
+		{"property", AllOf(ID().ContainsAny("5b6a08ba-1d46-43aa-89cc-8b070790c6f2"), TextProp("name").Equal("Hawaii")), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := tt.filter.MatchesObject(object)
+			require.NoError(t, err)
+			assert.Equal(t, tt.matches, matches)
+		})
+	}
+}
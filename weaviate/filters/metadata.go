@@ -0,0 +1,170 @@
+package filters
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	idPath                 = "_id"
+	creationTimeUnixPath   = "_creationTimeUnix"
+	lastUpdateTimeUnixPath = "_lastUpdateTimeUnix"
+)
+
+// IDProperty builds filters on the object id
+type IDProperty struct{}
+
+// ID starts a filter on the object id
+func ID() *IDProperty {
+	return &IDProperty{}
+}
+
+// Equal matches the object with the given id
+func (p *IDProperty) Equal(id string) *WhereBuilder {
+	return p.filter(Equal, id)
+}
+
+// NotEqual matches all objects except the one with the given id
+func (p *IDProperty) NotEqual(id string) *WhereBuilder {
+	return p.filter(NotEqual, id)
+}
+
+// ContainsAny matches the objects with any of the given ids
+func (p *IDProperty) ContainsAny(ids ...string) *WhereBuilder {
+	return p.filter(ContainsAny, ids...)
+}
+
+func (p *IDProperty) filter(operator WhereOperator, ids ...string) *WhereBuilder {
+	return Where().WithPath([]string{idPath}).WithOperator(operator).WithValueText(ids...)
+}
+
+// TimestampProperty builds filters on the creation or last update time of an object.
+// Weaviate expects these timestamps as unix milliseconds passed as text, which
+// the filters take care of. Filtering by timestamps requires indexTimestamps
+// to be enabled in the inverted index config of the class.
+type TimestampProperty struct {
+	path string
+}
+
+// CreationTime starts a filter on the creation time of the object
+func CreationTime() *TimestampProperty {
+	return &TimestampProperty{path: creationTimeUnixPath}
+}
+
+// LastUpdateTime starts a filter on the last update time of the object
+func LastUpdateTime() *TimestampProperty {
+	return &TimestampProperty{path: lastUpdateTimeUnixPath}
+}
+
+// Equal matches if the timestamp is equal to value
+func (p *TimestampProperty) Equal(value time.Time) *WhereBuilder {
+	return p.filter(Equal, value)
+}
+
+// NotEqual matches if the timestamp is not equal to value
+func (p *TimestampProperty) NotEqual(value time.Time) *WhereBuilder {
+	return p.filter(NotEqual, value)
+}
+
+// After matches if the timestamp is after value
+func (p *TimestampProperty) After(value time.Time) *WhereBuilder {
+	return p.filter(GreaterThan, value)
+}
+
+// AfterOrEqual matches if the timestamp is after or equal to value
+func (p *TimestampProperty) AfterOrEqual(value time.Time) *WhereBuilder {
+	return p.filter(GreaterThanEqual, value)
+}
+
+// Before matches if the timestamp is before value
+func (p *TimestampProperty) Before(value time.Time) *WhereBuilder {
+	return p.filter(LessThan, value)
+}
+
+// BeforeOrEqual matches if the timestamp is before or equal to value
+func (p *TimestampProperty) BeforeOrEqual(value time.Time) *WhereBuilder {
+	return p.filter(LessThanEqual, value)
+}
+
+// Between matches if the timestamp is within the range [from, to)
+func (p *TimestampProperty) Between(from, to time.Time) *WhereBuilder {
+	return AllOf(p.AfterOrEqual(from), p.Before(to))
+}
+
+// ContainsAny matches if the timestamp is equal to any of the values
+func (p *TimestampProperty) ContainsAny(values ...time.Time) *WhereBuilder {
+	return p.filter(ContainsAny, values...)
+}
+
+func (p *TimestampProperty) filter(operator WhereOperator, values ...time.Time) *WhereBuilder {
+	timestamps := make([]string, len(values))
+	for i := range values {
+		timestamps[i] = strconv.FormatInt(values[i].UnixMilli(), 10)
+	}
+	return Where().WithPath([]string{p.path}).WithOperator(operator).WithValueText(timestamps...)
+}
+
+// CountProperty builds filters comparing a count, like the length of a property
+// or the number of references, with an int value
+type CountProperty struct {
+	path []string
+}
+
+// PropertyLength starts a filter on the length of the property, e.g. the number of
+// characters of a text or the number of elements of an array. Requires indexPropertyLength
+// to be enabled in the inverted index config of the class.
+func PropertyLength(property string) *CountProperty {
+	return &CountProperty{path: []string{fmt.Sprintf("len(%s)", property)}}
+}
+
+// ReferenceCount starts a filter on the number of references of the reference property
+func ReferenceCount(property string) *CountProperty {
+	return &CountProperty{path: []string{property}}
+}
+
+// Equal matches if the count is equal to value
+func (p *CountProperty) Equal(value int64) *WhereBuilder {
+	return p.filter(Equal, value)
+}
+
+// NotEqual matches if the count is not equal to value
+func (p *CountProperty) NotEqual(value int64) *WhereBuilder {
+	return p.filter(NotEqual, value)
+}
+
+// GreaterThan matches if the count is greater than value
+func (p *CountProperty) GreaterThan(value int64) *WhereBuilder {
+	return p.filter(GreaterThan, value)
+}
+
+// GreaterThanEqual matches if the count is greater than or equal to value
+func (p *CountProperty) GreaterThanEqual(value int64) *WhereBuilder {
+	return p.filter(GreaterThanEqual, value)
+}
+
+// LessThan matches if the count is less than value
+func (p *CountProperty) LessThan(value int64) *WhereBuilder {
+	return p.filter(LessThan, value)
+}
+
+// LessThanEqual matches if the count is less than or equal to value
+func (p *CountProperty) LessThanEqual(value int64) *WhereBuilder {
+	return p.filter(LessThanEqual, value)
+}
+
+func (p *CountProperty) filter(operator WhereOperator, value int64) *WhereBuilder {
+	return Where().WithPath(p.path).WithOperator(operator).WithValueInt(value)
+}
+
+// PropertyIsNull matches objects where the property is not set.
+// Requires indexNullState to be enabled in the inverted index config of the class.
+func PropertyIsNull(property string) *WhereBuilder {
+	return isNullFilter([]string{property}, true)
+}
+
+// PropertyIsNotNull matches objects where the property is set.
+// Requires indexNullState to be enabled in the inverted index config of the class.
+func PropertyIsNotNull(property string) *WhereBuilder {
+	return isNullFilter([]string{property}, false)
+}
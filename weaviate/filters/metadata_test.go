@@ -0,0 +1,111 @@
+package filters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate-go-client/v4/test/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestMetadataFilters(t *testing.T) {
+	from := time.UnixMilli(1697716800000)
+	to := time.UnixMilli(1697803200000)
+	tests := []struct {
+		name   string
+		filter *WhereBuilder
+		string string
+		build  *models.WhereFilter
+	}{
+		{
+			name:   "id equal",
+			filter: ID().Equal("5b6a08ba-1d46-43aa-89cc-8b070790c6f2"),
+			string: `where:{operator: Equal path: ["_id"] valueText: "5b6a08ba-1d46-43aa-89cc-8b070790c6f2"}`,
+			build: &models.WhereFilter{
+				Operator:  "Equal",
+				Path:      []string{"_id"},
+				ValueText: helpers.StringPointer("5b6a08ba-1d46-43aa-89cc-8b070790c6f2"),
+			},
+		},
+		{
+			name:   "id contains any",
+			filter: ID().ContainsAny("5b6a08ba-1d46-43aa-89cc-8b070790c6f2"),
+			string: `where:{operator: ContainsAny path: ["_id"] valueText: ["5b6a08ba-1d46-43aa-89cc-8b070790c6f2"]}`,
+			build: &models.WhereFilter{
+				Operator:       "ContainsAny",
+				Path:           []string{"_id"},
+				ValueTextArray: []string{"5b6a08ba-1d46-43aa-89cc-8b070790c6f2"},
+			},
+		},
+		{
+			name:   "last update time after",
+			filter: LastUpdateTime().After(from),
+			string: `where:{operator: GreaterThan path: ["_lastUpdateTimeUnix"] valueText: "1697716800000"}`,
+			build: &models.WhereFilter{
+				Operator:  "GreaterThan",
+				Path:      []string{"_lastUpdateTimeUnix"},
+				ValueText: helpers.StringPointer("1697716800000"),
+			},
+		},
+		{
+			name:   "creation time between",
+			filter: CreationTime().Between(from, to),
+			string: `where:{operator: And operands:[` +
+				`{operator: GreaterThanEqual path: ["_creationTimeUnix"] valueText: "1697716800000"},` +
+				`{operator: LessThan path: ["_creationTimeUnix"] valueText: "1697803200000"}]}`,
+			build: &models.WhereFilter{
+				Operator: "And",
+				Operands: []*models.WhereFilter{
+					{
+						Operator:  "GreaterThanEqual",
+						Path:      []string{"_creationTimeUnix"},
+						ValueText: helpers.StringPointer("1697716800000"),
+					},
+					{
+						Operator:  "LessThan",
+						Path:      []string{"_creationTimeUnix"},
+						ValueText: helpers.StringPointer("1697803200000"),
+					},
+				},
+			},
+		},
+		{
+			name:   "property length",
+			filter: PropertyLength("name").GreaterThanEqual(5),
+			string: `where:{operator: GreaterThanEqual path: ["len(name)"] valueInt: 5}`,
+			build: &models.WhereFilter{
+				Operator: "GreaterThanEqual",
+				Path:     []string{"len(name)"},
+				ValueInt: helpers.Int64Pointer(5),
+			},
+		},
+		{
+			name:   "reference count",
+			filter: ReferenceCount("hasWritten").LessThan(3),
+			string: `where:{operator: LessThan path: ["hasWritten"] valueInt: 3}`,
+			build: &models.WhereFilter{
+				Operator: "LessThan",
+				Path:     []string{"hasWritten"},
+				ValueInt: helpers.Int64Pointer(3),
+			},
+		},
+		{
+			name:   "property is not null",
+			filter: PropertyIsNotNull("description"),
+			string: `where:{operator: IsNull path: ["description"] valueBoolean: false}`,
+			build: &models.WhereFilter{
+				Operator:     "IsNull",
+				Path:         []string{"description"},
+				ValueBoolean: helpers.BoolPointer(false),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.string, tt.filter.String())
+			assert.Equal(t, tt.build, tt.filter.Build())
+			assert.Nil(t, tt.filter.Validate())
+		})
+	}
+}
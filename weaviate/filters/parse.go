@@ -0,0 +1,504 @@
+package filters
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// FromWhereFilter creates a *WhereBuilder from a *models.WhereFilter, e.g. one that
+// was stored as JSON. It is the inverse of Build.
+func FromWhereFilter(filter *models.WhereFilter) (*WhereBuilder, error) {
+	return fromWhereFilter(filter, "where")
+}
+
+func fromWhereFilter(filter *models.WhereFilter, location string) (*WhereBuilder, error) {
+	if filter == nil {
+		return nil, fmt.Errorf("%s: filter is nil", location)
+	}
+	b := Where().WithOperator(WhereOperator(filter.Operator))
+	if filter.Path != nil {
+		b.WithPath(filter.Path)
+	}
+	if filter.ValueInt != nil {
+		b.WithValueInt(*filter.ValueInt)
+	} else if filter.ValueIntArray != nil {
+		b.WithValueInt(filter.ValueIntArray...)
+	}
+	if filter.ValueNumber != nil {
+		b.WithValueNumber(*filter.ValueNumber)
+	} else if filter.ValueNumberArray != nil {
+		b.WithValueNumber(filter.ValueNumberArray...)
+	}
+	if filter.ValueBoolean != nil {
+		b.WithValueBoolean(*filter.ValueBoolean)
+	} else if filter.ValueBooleanArray != nil {
+		b.WithValueBoolean(filter.ValueBooleanArray...)
+	}
+	if filter.ValueString != nil {
+		b.WithValueString(*filter.ValueString)
+	} else if filter.ValueStringArray != nil {
+		b.WithValueString(filter.ValueStringArray...)
+	}
+	if filter.ValueText != nil {
+		b.WithValueText(*filter.ValueText)
+	} else if filter.ValueTextArray != nil {
+		b.WithValueText(filter.ValueTextArray...)
+	}
+	dates := filter.ValueDateArray
+	if filter.ValueDate != nil {
+		dates = []string{*filter.ValueDate}
+	}
+	if dates != nil {
+		valueDate, err := parseDates(dates)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", location, err)
+		}
+		b.WithValueDate(valueDate...)
+	}
+	if filter.ValueGeoRange != nil {
+		geoRange := filter.ValueGeoRange
+		if geoRange.GeoCoordinates == nil || geoRange.GeoCoordinates.Latitude == nil ||
+			geoRange.GeoCoordinates.Longitude == nil || geoRange.Distance == nil {
+			return nil, fmt.Errorf("%s: valueGeoRange requires geoCoordinates and distance", location)
+		}
+		b.WithValueGeoRange(&GeoCoordinatesParameter{
+			Latitude:    *geoRange.GeoCoordinates.Latitude,
+			Longitude:   *geoRange.GeoCoordinates.Longitude,
+			MaxDistance: float32(geoRange.Distance.Max),
+		})
+	}
+	if len(filter.Operands) > 0 {
+		operands := make([]*WhereBuilder, len(filter.Operands))
+		for i := range filter.Operands {
+			operand, err := fromWhereFilter(filter.Operands[i], fmt.Sprintf("%s.operands[%d]", location, i))
+			if err != nil {
+				return nil, err
+			}
+			operands[i] = operand
+		}
+		b.WithOperands(operands)
+	}
+	return b, nil
+}
+
+func parseDates(values []string) ([]time.Time, error) {
+	dates := make([]time.Time, len(values))
+	for i := range values {
+		date, err := time.Parse(time.RFC3339Nano, values[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid valueDate %q: %w", values[i], err)
+		}
+		dates[i] = date
+	}
+	return dates, nil
+}
+
+// Parse creates a *WhereBuilder from a GraphQL where clause. It accepts the output
+// of String, i.e. where:{...}, as well as the bare input object {...}.
+func Parse(where string) (*WhereBuilder, error) {
+	p := &gqlParser{input: where}
+	p.skipIgnored()
+	if strings.HasPrefix(p.input[p.pos:], "where") {
+		p.pos += len("where")
+		p.skipIgnored()
+		if err := p.expect(':'); err != nil {
+			return nil, err
+		}
+	}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	p.skipIgnored()
+	if p.pos < len(p.input) {
+		return nil, p.errorf("unexpected %q after where filter", p.input[p.pos])
+	}
+	object, ok := value.(gqlObject)
+	if !ok {
+		return nil, fmt.Errorf("parse where filter: expected an object")
+	}
+	return fromGQLObject(object, "where")
+}
+
+// gqlObject is a parsed GraphQL input object, values are strings, gqlNumber,
+// gqlEnum, bool, nil, []interface{} or gqlObject
+type gqlObject map[string]interface{}
+
+type (
+	gqlNumber string
+	gqlEnum   string
+)
+
+func fromGQLObject(object gqlObject, location string) (*WhereBuilder, error) {
+	errorf := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%s: %s", location, fmt.Sprintf(format, args...))
+	}
+	b := Where()
+	for key, value := range object {
+		switch key {
+		case "operator":
+			operator, ok := value.(gqlEnum)
+			if !ok {
+				return nil, errorf("operator has to be an enum value, got %v", value)
+			}
+			b.WithOperator(WhereOperator(operator))
+		case "path":
+			path, err := gqlList(value, func(v interface{}) (string, bool) {
+				s, ok := v.(string)
+				return s, ok
+			})
+			if err != nil {
+				return nil, errorf("path: %s", err)
+			}
+			b.WithPath(path)
+		case "valueInt":
+			values, err := gqlList(value, func(v interface{}) (int64, bool) {
+				n, ok := v.(gqlNumber)
+				if !ok {
+					return 0, false
+				}
+				i, err := strconv.ParseInt(string(n), 10, 64)
+				return i, err == nil
+			})
+			if err != nil {
+				return nil, errorf("valueInt: %s", err)
+			}
+			b.WithValueInt(values...)
+		case "valueNumber":
+			values, err := gqlList(value, gqlFloat)
+			if err != nil {
+				return nil, errorf("valueNumber: %s", err)
+			}
+			b.WithValueNumber(values...)
+		case "valueBoolean":
+			values, err := gqlList(value, func(v interface{}) (bool, bool) {
+				b, ok := v.(bool)
+				return b, ok
+			})
+			if err != nil {
+				return nil, errorf("valueBoolean: %s", err)
+			}
+			b.WithValueBoolean(values...)
+		case "valueString", "valueText", "valueDate":
+			values, err := gqlList(value, func(v interface{}) (string, bool) {
+				s, ok := v.(string)
+				return s, ok
+			})
+			if err != nil {
+				return nil, errorf("%s: %s", key, err)
+			}
+			switch key {
+			case "valueString":
+				b.WithValueString(values...)
+			case "valueText":
+				b.WithValueText(values...)
+			default:
+				dates, err := parseDates(values)
+				if err != nil {
+					return nil, errorf("%s", err)
+				}
+				b.WithValueDate(dates...)
+			}
+		case "valueGeoRange":
+			geoRange, err := gqlGeoRange(value)
+			if err != nil {
+				return nil, errorf("valueGeoRange: %s", err)
+			}
+			b.WithValueGeoRange(geoRange)
+		case "operands":
+			operands, err := gqlList(value, func(v interface{}) (gqlObject, bool) {
+				o, ok := v.(gqlObject)
+				return o, ok
+			})
+			if err != nil {
+				return nil, errorf("operands: %s", err)
+			}
+			builders := make([]*WhereBuilder, len(operands))
+			for i := range operands {
+				builder, err := fromGQLObject(operands[i], fmt.Sprintf("%s.operands[%d]", location, i))
+				if err != nil {
+					return nil, err
+				}
+				builders[i] = builder
+			}
+			b.WithOperands(builders)
+		default:
+			return nil, errorf("unknown field %s", key)
+		}
+	}
+	return b, nil
+}
+
+// gqlList converts a list value, or a single value which GraphQL coerces to a list of one
+func gqlList[T any](value interface{}, convert func(interface{}) (T, bool)) ([]T, error) {
+	values, isList := value.([]interface{})
+	if !isList {
+		values = []interface{}{value}
+	}
+	result := make([]T, len(values))
+	for i := range values {
+		converted, ok := convert(values[i])
+		if !ok {
+			return nil, fmt.Errorf("unexpected value %v", values[i])
+		}
+		result[i] = converted
+	}
+	return result, nil
+}
+
+func gqlFloat(v interface{}) (float64, bool) {
+	n, ok := v.(gqlNumber)
+	if !ok {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(string(n), 64)
+	return f, err == nil
+}
+
+func gqlGeoRange(value interface{}) (*GeoCoordinatesParameter, error) {
+	object, ok := value.(gqlObject)
+	if !ok {
+		return nil, fmt.Errorf("expected an object")
+	}
+	coordinates, ok := object["geoCoordinates"].(gqlObject)
+	if !ok {
+		return nil, fmt.Errorf("geoCoordinates is missing")
+	}
+	distance, ok := object["distance"].(gqlObject)
+	if !ok {
+		return nil, fmt.Errorf("distance is missing")
+	}
+	latitude, ok := gqlFloat(coordinates["latitude"])
+	if !ok {
+		return nil, fmt.Errorf("invalid latitude %v", coordinates["latitude"])
+	}
+	longitude, ok := gqlFloat(coordinates["longitude"])
+	if !ok {
+		return nil, fmt.Errorf("invalid longitude %v", coordinates["longitude"])
+	}
+	maxDistance, ok := gqlFloat(distance["max"])
+	if !ok {
+		return nil, fmt.Errorf("invalid distance max %v", distance["max"])
+	}
+	return &GeoCoordinatesParameter{
+		Latitude:    float32(latitude),
+		Longitude:   float32(longitude),
+		MaxDistance: float32(maxDistance),
+	}, nil
+}
+
+// gqlParser parses GraphQL input values as described in
+// https://spec.graphql.org/October2021/#sec-Input-Values
+type gqlParser struct {
+	input string
+	pos   int
+}
+
+func (p *gqlParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("parse where filter at offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// skipIgnored skips whitespace, commas and comments
+func (p *gqlParser) skipIgnored() {
+	for p.pos < len(p.input) {
+		switch p.input[p.pos] {
+		case ' ', '\t', '\n', '\r', ',':
+			p.pos++
+		case '#':
+			for p.pos < len(p.input) && p.input[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *gqlParser) expect(c byte) error {
+	if p.pos >= len(p.input) || p.input[p.pos] != c {
+		return p.errorf("expected %q", c)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *gqlParser) parseValue() (interface{}, error) {
+	p.skipIgnored()
+	if p.pos >= len(p.input) {
+		return nil, p.errorf("unexpected end of input")
+	}
+	switch c := p.input[p.pos]; {
+	case c == '{':
+		return p.parseObject()
+	case c == '[':
+		return p.parseList()
+	case c == '"':
+		return p.parseString()
+	case c == '-' || isDigit(c):
+		return p.parseNumber(), nil
+	case isNameStart(c):
+		switch name := p.parseName(); name {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		default:
+			return gqlEnum(name), nil
+		}
+	default:
+		return nil, p.errorf("unexpected %q", c)
+	}
+}
+
+func (p *gqlParser) parseObject() (gqlObject, error) {
+	p.pos++
+	object := gqlObject{}
+	for {
+		p.skipIgnored()
+		if p.pos >= len(p.input) {
+			return nil, p.errorf("unterminated object")
+		}
+		if p.input[p.pos] == '}' {
+			p.pos++
+			return object, nil
+		}
+		if !isNameStart(p.input[p.pos]) {
+			return nil, p.errorf("expected field name")
+		}
+		name := p.parseName()
+		p.skipIgnored()
+		if err := p.expect(':'); err != nil {
+			return nil, err
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := object[name]; exists {
+			return nil, p.errorf("duplicate field %s", name)
+		}
+		object[name] = value
+	}
+}
+
+func (p *gqlParser) parseList() ([]interface{}, error) {
+	p.pos++
+	list := []interface{}{}
+	for {
+		p.skipIgnored()
+		if p.pos >= len(p.input) {
+			return nil, p.errorf("unterminated list")
+		}
+		if p.input[p.pos] == ']' {
+			p.pos++
+			return list, nil
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, value)
+	}
+}
+
+func (p *gqlParser) parseName() string {
+	start := p.pos
+	for p.pos < len(p.input) && (isNameStart(p.input[p.pos]) || isDigit(p.input[p.pos])) {
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *gqlParser) parseNumber() gqlNumber {
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if !isDigit(c) && c != '-' && c != '+' && c != '.' && c != 'e' && c != 'E' {
+			break
+		}
+		p.pos++
+	}
+	return gqlNumber(p.input[start:p.pos])
+}
+
+func (p *gqlParser) parseString() (string, error) {
+	p.pos++
+	var sb strings.Builder
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		switch c {
+		case '"':
+			p.pos++
+			return sb.String(), nil
+		case '\\':
+			if p.pos+1 >= len(p.input) {
+				return "", p.errorf("unterminated string")
+			}
+			escaped := p.input[p.pos+1]
+			p.pos += 2
+			switch escaped {
+			case '"', '\\', '/':
+				sb.WriteByte(escaped)
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'u':
+				r, err := p.parseUnicodeEscape()
+				if err != nil {
+					return "", err
+				}
+				if utf16.IsSurrogate(r) && strings.HasPrefix(p.input[p.pos:], `\u`) {
+					p.pos += 2
+					low, err := p.parseUnicodeEscape()
+					if err != nil {
+						return "", err
+					}
+					r = utf16.DecodeRune(r, low)
+				}
+				sb.WriteRune(r)
+			default:
+				return "", p.errorf("invalid escape sequence \\%c", escaped)
+			}
+		case '\n', '\r':
+			return "", p.errorf("unterminated string")
+		default:
+			sb.WriteByte(c)
+			p.pos++
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *gqlParser) parseUnicodeEscape() (rune, error) {
+	if p.pos+4 > len(p.input) {
+		return 0, p.errorf("invalid unicode escape sequence")
+	}
+	code, err := strconv.ParseUint(p.input[p.pos:p.pos+4], 16, 32)
+	if err != nil {
+		return 0, p.errorf("invalid unicode escape sequence")
+	}
+	p.pos += 4
+	return rune(code), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
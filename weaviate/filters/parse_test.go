@@ -0,0 +1,105 @@
+package filters
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func roundTripFilters() map[string]*WhereBuilder {
+	date := time.Date(2023, 10, 19, 12, 30, 0, 500, time.UTC)
+	return map[string]*WhereBuilder{
+		"text":          TextProp("name").Equal("Hawaii \"special\"\nä\U0001F355"),
+		"string":        Where().WithOperator(Like).WithPath([]string{"name"}).WithValueString("Haw*"),
+		"int":           IntProp("size").GreaterThan(-3),
+		"number":        NumberProp("price").LessThanEqual(23.99),
+		"boolean":       BooleanProp("spicy").NotEqual(true),
+		"date":          DateProp("published").ContainsAny(date, date.Add(time.Hour)),
+		"geo range":     GeoProp("location").WithinGeoRange(51.51, -0.09, 2000),
+		"contains text": TextProp("tags").ContainsAll("italian"),
+		"nested": AllOf(
+			NumberProp("price").LessThan(20),
+			AnyOf(TextProp("inPublication", "Publication", "name").Equal("NYT"), PropertyIsNull("name")),
+			Negate(ID().ContainsAny("5b6a08ba-1d46-43aa-89cc-8b070790c6f2")),
+		),
+	}
+}
+
+func TestFromWhereFilter(t *testing.T) {
+	for name, filter := range roundTripFilters() {
+		t.Run(name, func(t *testing.T) {
+			// round trip through JSON as filters are stored that way
+			data, err := json.Marshal(filter.Build())
+			require.NoError(t, err)
+			var model models.WhereFilter
+			require.NoError(t, json.Unmarshal(data, &model))
+
+			parsed, err := FromWhereFilter(&model)
+			require.NoError(t, err)
+			assert.Equal(t, filter.Build(), parsed.Build())
+			assert.Equal(t, filter.String(), parsed.String())
+		})
+	}
+
+	t.Run("invalid date", func(t *testing.T) {
+		_, err := FromWhereFilter(&models.WhereFilter{
+			Operator: "And",
+			Operands: []*models.WhereFilter{{Operator: "Equal", Path: []string{"published"}, ValueDateArray: []string{"yesterday"}}},
+		})
+		assert.ErrorContains(t, err, "where.operands[0]: invalid valueDate \"yesterday\"")
+	})
+}
+
+func TestParse(t *testing.T) {
+	for name, filter := range roundTripFilters() {
+		t.Run(name, func(t *testing.T) {
+			parsed, err := Parse(filter.String())
+			require.NoError(t, err)
+			assert.Equal(t, filter.Build(), parsed.Build())
+			assert.Equal(t, filter.String(), parsed.String())
+		})
+	}
+
+	t.Run("bare object with commas, comments and single path", func(t *testing.T) {
+		parsed, err := Parse(`{
+			operator: Or, # either of
+			operands: [
+				{path: "name", operator: Equal, valueText: "Hawaii"},
+				{path: ["price"], operator: LessThan, valueNumber: 2e1},
+			]
+		}`)
+		require.NoError(t, err)
+		expected := AnyOf(TextProp("name").Equal("Hawaii"), NumberProp("price").LessThan(20))
+		assert.Equal(t, expected.Build(), parsed.Build())
+	})
+
+	t.Run("escaped strings", func(t *testing.T) {
+		parsed, err := Parse(`{operator: Equal path: ["name"] valueText: "a\/bä🍕\t"}`)
+		require.NoError(t, err)
+		assert.Equal(t, TextProp("name").Equal("a/bä\U0001F355\t").Build(), parsed.Build())
+	})
+
+	errorTests := map[string]struct {
+		where string
+		err   string
+	}{
+		"unterminated object": {`where:{operator: Equal`, "unterminated object"},
+		"unterminated string": {`{valueText: "abc}`, "unterminated string"},
+		"operator as string":  {`{operator: "Equal"}`, "where: operator has to be an enum value"},
+		"unknown field":       {`{operator: Equal, value: 1}`, "where: unknown field value"},
+		"float as int":        {`{operands: [{valueInt: 1.5}]}`, "where.operands[0]: valueInt: unexpected value 1.5"},
+		"trailing input":      {`{operator: Equal} }`, "unexpected '}' after where filter"},
+		"not an object":       {`where: [1]`, "expected an object"},
+		"invalid geo range":   {`{valueGeoRange: {geoCoordinates: {latitude: 1, longitude: 2}}}`, "valueGeoRange: distance is missing"},
+	}
+	for name, tt := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(tt.where)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
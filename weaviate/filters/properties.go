@@ -0,0 +1,328 @@
+package filters
+
+import "time"
+
+// AllOf combines the filters with the And operator
+func AllOf(filters ...*WhereBuilder) *WhereBuilder {
+	return Where().WithOperator(And).WithOperands(filters)
+}
+
+// AnyOf combines the filters with the Or operator
+func AnyOf(filters ...*WhereBuilder) *WhereBuilder {
+	return Where().WithOperator(Or).WithOperands(filters)
+}
+
+// Negate inverts the filter with the Not operator
+func Negate(filter *WhereBuilder) *WhereBuilder {
+	return Where().WithOperator(Not).WithOperands([]*WhereBuilder{filter})
+}
+
+// TextProperty builds filters on a text property. Only operators supported by text
+// properties are available, values are passed as valueText.
+type TextProperty struct {
+	path []string
+}
+
+// TextProp starts a filter on the text property at the given path,
+// e.g. TextProp("inPublication", "Publication", "name")
+func TextProp(path ...string) *TextProperty {
+	return &TextProperty{path: path}
+}
+
+// Equal matches if the property is equal to value
+func (p *TextProperty) Equal(value string) *WhereBuilder {
+	return p.filter(Equal, value)
+}
+
+// NotEqual matches if the property is not equal to value
+func (p *TextProperty) NotEqual(value string) *WhereBuilder {
+	return p.filter(NotEqual, value)
+}
+
+// Like matches the property against a pattern, where ? matches exactly one and * any number of characters
+func (p *TextProperty) Like(pattern string) *WhereBuilder {
+	return p.filter(Like, pattern)
+}
+
+// GreaterThan matches if the property is greater than value
+func (p *TextProperty) GreaterThan(value string) *WhereBuilder {
+	return p.filter(GreaterThan, value)
+}
+
+// GreaterThanEqual matches if the property is greater than or equal to value
+func (p *TextProperty) GreaterThanEqual(value string) *WhereBuilder {
+	return p.filter(GreaterThanEqual, value)
+}
+
+// LessThan matches if the property is less than value
+func (p *TextProperty) LessThan(value string) *WhereBuilder {
+	return p.filter(LessThan, value)
+}
+
+// LessThanEqual matches if the property is less than or equal to value
+func (p *TextProperty) LessThanEqual(value string) *WhereBuilder {
+	return p.filter(LessThanEqual, value)
+}
+
+// ContainsAny matches if the property contains at least one of the values
+func (p *TextProperty) ContainsAny(values ...string) *WhereBuilder {
+	return p.filter(ContainsAny, values...)
+}
+
+// ContainsAll matches if the property contains all of the values
+func (p *TextProperty) ContainsAll(values ...string) *WhereBuilder {
+	return p.filter(ContainsAll, values...)
+}
+
+// IsNull matches if the null state of the property equals isNull
+func (p *TextProperty) IsNull(isNull bool) *WhereBuilder {
+	return isNullFilter(p.path, isNull)
+}
+
+func (p *TextProperty) filter(operator WhereOperator, values ...string) *WhereBuilder {
+	return Where().WithPath(p.path).WithOperator(operator).WithValueText(values...)
+}
+
+// IntProperty builds filters on an int property
+type IntProperty struct {
+	path []string
+}
+
+// IntProp starts a filter on the int property at the given path
+func IntProp(path ...string) *IntProperty {
+	return &IntProperty{path: path}
+}
+
+// Equal matches if the property is equal to value
+func (p *IntProperty) Equal(value int64) *WhereBuilder {
+	return p.filter(Equal, value)
+}
+
+// NotEqual matches if the property is not equal to value
+func (p *IntProperty) NotEqual(value int64) *WhereBuilder {
+	return p.filter(NotEqual, value)
+}
+
+// GreaterThan matches if the property is greater than value
+func (p *IntProperty) GreaterThan(value int64) *WhereBuilder {
+	return p.filter(GreaterThan, value)
+}
+
+// GreaterThanEqual matches if the property is greater than or equal to value
+func (p *IntProperty) GreaterThanEqual(value int64) *WhereBuilder {
+	return p.filter(GreaterThanEqual, value)
+}
+
+// LessThan matches if the property is less than value
+func (p *IntProperty) LessThan(value int64) *WhereBuilder {
+	return p.filter(LessThan, value)
+}
+
+// LessThanEqual matches if the property is less than or equal to value
+func (p *IntProperty) LessThanEqual(value int64) *WhereBuilder {
+	return p.filter(LessThanEqual, value)
+}
+
+// ContainsAny matches if the property contains at least one of the values
+func (p *IntProperty) ContainsAny(values ...int64) *WhereBuilder {
+	return p.filter(ContainsAny, values...)
+}
+
+// ContainsAll matches if the property contains all of the values
+func (p *IntProperty) ContainsAll(values ...int64) *WhereBuilder {
+	return p.filter(ContainsAll, values...)
+}
+
+// IsNull matches if the null state of the property equals isNull
+func (p *IntProperty) IsNull(isNull bool) *WhereBuilder {
+	return isNullFilter(p.path, isNull)
+}
+
+func (p *IntProperty) filter(operator WhereOperator, values ...int64) *WhereBuilder {
+	return Where().WithPath(p.path).WithOperator(operator).WithValueInt(values...)
+}
+
+// NumberProperty builds filters on a number property
+type NumberProperty struct {
+	path []string
+}
+
+// NumberProp starts a filter on the number property at the given path
+func NumberProp(path ...string) *NumberProperty {
+	return &NumberProperty{path: path}
+}
+
+// Equal matches if the property is equal to value
+func (p *NumberProperty) Equal(value float64) *WhereBuilder {
+	return p.filter(Equal, value)
+}
+
+// NotEqual matches if the property is not equal to value
+func (p *NumberProperty) NotEqual(value float64) *WhereBuilder {
+	return p.filter(NotEqual, value)
+}
+
+// GreaterThan matches if the property is greater than value
+func (p *NumberProperty) GreaterThan(value float64) *WhereBuilder {
+	return p.filter(GreaterThan, value)
+}
+
+// GreaterThanEqual matches if the property is greater than or equal to value
+func (p *NumberProperty) GreaterThanEqual(value float64) *WhereBuilder {
+	return p.filter(GreaterThanEqual, value)
+}
+
+// LessThan matches if the property is less than value
+func (p *NumberProperty) LessThan(value float64) *WhereBuilder {
+	return p.filter(LessThan, value)
+}
+
+// LessThanEqual matches if the property is less than or equal to value
+func (p *NumberProperty) LessThanEqual(value float64) *WhereBuilder {
+	return p.filter(LessThanEqual, value)
+}
+
+// ContainsAny matches if the property contains at least one of the values
+func (p *NumberProperty) ContainsAny(values ...float64) *WhereBuilder {
+	return p.filter(ContainsAny, values...)
+}
+
+// ContainsAll matches if the property contains all of the values
+func (p *NumberProperty) ContainsAll(values ...float64) *WhereBuilder {
+	return p.filter(ContainsAll, values...)
+}
+
+// IsNull matches if the null state of the property equals isNull
+func (p *NumberProperty) IsNull(isNull bool) *WhereBuilder {
+	return isNullFilter(p.path, isNull)
+}
+
+func (p *NumberProperty) filter(operator WhereOperator, values ...float64) *WhereBuilder {
+	return Where().WithPath(p.path).WithOperator(operator).WithValueNumber(values...)
+}
+
+// BooleanProperty builds filters on a boolean property
+type BooleanProperty struct {
+	path []string
+}
+
+// BooleanProp starts a filter on the boolean property at the given path
+func BooleanProp(path ...string) *BooleanProperty {
+	return &BooleanProperty{path: path}
+}
+
+// Equal matches if the property is equal to value
+func (p *BooleanProperty) Equal(value bool) *WhereBuilder {
+	return p.filter(Equal, value)
+}
+
+// NotEqual matches if the property is not equal to value
+func (p *BooleanProperty) NotEqual(value bool) *WhereBuilder {
+	return p.filter(NotEqual, value)
+}
+
+// ContainsAny matches if the property contains at least one of the values
+func (p *BooleanProperty) ContainsAny(values ...bool) *WhereBuilder {
+	return p.filter(ContainsAny, values...)
+}
+
+// ContainsAll matches if the property contains all of the values
+func (p *BooleanProperty) ContainsAll(values ...bool) *WhereBuilder {
+	return p.filter(ContainsAll, values...)
+}
+
+// IsNull matches if the null state of the property equals isNull
+func (p *BooleanProperty) IsNull(isNull bool) *WhereBuilder {
+	return isNullFilter(p.path, isNull)
+}
+
+func (p *BooleanProperty) filter(operator WhereOperator, values ...bool) *WhereBuilder {
+	return Where().WithPath(p.path).WithOperator(operator).WithValueBoolean(values...)
+}
+
+// DateProperty builds filters on a date property
+type DateProperty struct {
+	path []string
+}
+
+// DateProp starts a filter on the date property at the given path
+func DateProp(path ...string) *DateProperty {
+	return &DateProperty{path: path}
+}
+
+// Equal matches if the property is equal to value
+func (p *DateProperty) Equal(value time.Time) *WhereBuilder {
+	return p.filter(Equal, value)
+}
+
+// NotEqual matches if the property is not equal to value
+func (p *DateProperty) NotEqual(value time.Time) *WhereBuilder {
+	return p.filter(NotEqual, value)
+}
+
+// GreaterThan matches if the property is after value
+func (p *DateProperty) GreaterThan(value time.Time) *WhereBuilder {
+	return p.filter(GreaterThan, value)
+}
+
+// GreaterThanEqual matches if the property is after or equal to value
+func (p *DateProperty) GreaterThanEqual(value time.Time) *WhereBuilder {
+	return p.filter(GreaterThanEqual, value)
+}
+
+// LessThan matches if the property is before value
+func (p *DateProperty) LessThan(value time.Time) *WhereBuilder {
+	return p.filter(LessThan, value)
+}
+
+// LessThanEqual matches if the property is before or equal to value
+func (p *DateProperty) LessThanEqual(value time.Time) *WhereBuilder {
+	return p.filter(LessThanEqual, value)
+}
+
+// ContainsAny matches if the property contains at least one of the values
+func (p *DateProperty) ContainsAny(values ...time.Time) *WhereBuilder {
+	return p.filter(ContainsAny, values...)
+}
+
+// ContainsAll matches if the property contains all of the values
+func (p *DateProperty) ContainsAll(values ...time.Time) *WhereBuilder {
+	return p.filter(ContainsAll, values...)
+}
+
+// IsNull matches if the null state of the property equals isNull
+func (p *DateProperty) IsNull(isNull bool) *WhereBuilder {
+	return isNullFilter(p.path, isNull)
+}
+
+func (p *DateProperty) filter(operator WhereOperator, values ...time.Time) *WhereBuilder {
+	return Where().WithPath(p.path).WithOperator(operator).WithValueDate(values...)
+}
+
+// GeoProperty builds filters on a geoCoordinates property
+type GeoProperty struct {
+	path []string
+}
+
+// GeoProp starts a filter on the geoCoordinates property at the given path
+func GeoProp(path ...string) *GeoProperty {
+	return &GeoProperty{path: path}
+}
+
+// WithinGeoRange matches if the property is at most maxDistance meters away from the given coordinates
+func (p *GeoProperty) WithinGeoRange(latitude, longitude, maxDistance float32) *WhereBuilder {
+	return Where().WithPath(p.path).WithOperator(WithinGeoRange).WithValueGeoRange(&GeoCoordinatesParameter{
+		Latitude:    latitude,
+		Longitude:   longitude,
+		MaxDistance: maxDistance,
+	})
+}
+
+// IsNull matches if the null state of the property equals isNull
+func (p *GeoProperty) IsNull(isNull bool) *WhereBuilder {
+	return isNullFilter(p.path, isNull)
+}
+
+func isNullFilter(path []string, isNull bool) *WhereBuilder {
+	return Where().WithPath(path).WithOperator(IsNull).WithValueBoolean(isNull)
+}
@@ -0,0 +1,75 @@
+package filters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProperties_String(t *testing.T) {
+	date := time.Date(2023, 10, 19, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		filter *WhereBuilder
+		want   string
+	}{
+		{
+			name:   "number greater than",
+			filter: NumberProp("price").GreaterThan(10),
+			want:   `where:{operator: GreaterThan path: ["price"] valueNumber: 10}`,
+		},
+		{
+			name:   "int less than equal",
+			filter: IntProp("size").LessThanEqual(3),
+			want:   `where:{operator: LessThanEqual path: ["size"] valueInt: 3}`,
+		},
+		{
+			name:   "text like on reference",
+			filter: TextProp("inPublication", "Publication", "name").Like("New *"),
+			want:   `where:{operator: Like path: ["inPublication","Publication","name"] valueText: "New *"}`,
+		},
+		{
+			name:   "text contains any",
+			filter: TextProp("tags").ContainsAny("italian", "vegan"),
+			want:   `where:{operator: ContainsAny path: ["tags"] valueText: ["italian","vegan"]}`,
+		},
+		{
+			name:   "boolean contains all",
+			filter: BooleanProp("flags").ContainsAll(true),
+			want:   `where:{operator: ContainsAll path: ["flags"] valueBoolean: [true]}`,
+		},
+		{
+			name:   "date equal",
+			filter: DateProp("published").Equal(date),
+			want:   `where:{operator: Equal path: ["published"] valueDate: "2023-10-19T12:00:00Z"}`,
+		},
+		{
+			name:   "geo range",
+			filter: GeoProp("location").WithinGeoRange(51.51, -0.09, 2000),
+			want:   `where:{operator: WithinGeoRange path: ["location"] valueGeoRange: {geoCoordinates:{latitude:51.51,longitude:-0.09},distance:{max:2000}}}`,
+		},
+		{
+			name:   "is null",
+			filter: TextProp("description").IsNull(true),
+			want:   `where:{operator: IsNull path: ["description"] valueBoolean: true}`,
+		},
+		{
+			name: "nested and, or and not",
+			filter: AllOf(
+				NumberProp("price").LessThan(20),
+				AnyOf(TextProp("name").Equal("Hawaii"), TextProp("name").Equal("Doener")),
+				Negate(BooleanProp("spicy").Equal(true)),
+			),
+			want: `where:{operator: And operands:[{operator: LessThan path: ["price"] valueNumber: 20},` +
+				`{operator: Or operands:[{operator: Equal path: ["name"] valueText: "Hawaii"},{operator: Equal path: ["name"] valueText: "Doener"}]},` +
+				`{operator: Not operands:[{operator: Equal path: ["spicy"] valueBoolean: true}]}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.String())
+			assert.Nil(t, tt.filter.Validate())
+		})
+	}
+}
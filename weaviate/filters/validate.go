@@ -0,0 +1,148 @@
+package filters
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError lists the structural problems found in a where filter
+type ValidationError struct {
+	Problems []string
+}
+
+// Error message listing all problems
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid where filter: %s", strings.Join(e.Problems, "; "))
+}
+
+// Validate checks the structure of the filter, so that malformed filters are reported
+// before a request is sent. It checks that
+//   - And and Or have operands and Not exactly one, but neither path nor value
+//   - all other operators have a path and a single kind of value, but no operands
+//   - Like is used with text or string values
+//   - WithinGeoRange is used with a geo range and IsNull with a single boolean
+//   - only ContainsAny and ContainsAll are given multiple values
+//
+// Returns a *ValidationError listing all problems or nil if the filter is valid.
+func (b *WhereBuilder) Validate() error {
+	var problems []string
+	b.validate("where", &problems)
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+func (b *WhereBuilder) validate(location string, problems *[]string) {
+	report := func(format string, args ...interface{}) {
+		*problems = append(*problems, fmt.Sprintf("%s: %s", location, fmt.Sprintf(format, args...)))
+	}
+
+	valueKinds := b.valueKinds()
+	switch b.operator {
+	case "":
+		report("operator is missing")
+	case And, Or, Not:
+		if len(b.operands) == 0 {
+			report("operator %s requires operands", b.operator)
+		}
+		if b.operator == Not && len(b.operands) > 1 {
+			report("operator %s requires exactly one operand, got %d", b.operator, len(b.operands))
+		}
+		if len(b.path) > 0 {
+			report("operator %s does not accept a path", b.operator)
+		}
+		if len(valueKinds) > 0 {
+			report("operator %s does not accept a value, got %s", b.operator, strings.Join(valueKinds, ", "))
+		}
+	case Equal, NotEqual, GreaterThan, GreaterThanEqual, LessThan, LessThanEqual,
+		Like, WithinGeoRange, IsNull, ContainsAny, ContainsAll:
+		if len(b.path) == 0 {
+			report("operator %s requires a path", b.operator)
+		}
+		if len(b.operands) > 0 {
+			report("operator %s does not accept operands", b.operator)
+		}
+		switch {
+		case len(valueKinds) == 0:
+			report("operator %s requires a value", b.operator)
+		case len(valueKinds) > 1:
+			report("operator %s accepts a single kind of value, got %s", b.operator, strings.Join(valueKinds, ", "))
+		default:
+			b.validateValue(valueKinds[0], report)
+		}
+	default:
+		report("unknown operator %s", b.operator)
+	}
+
+	for i, operand := range b.operands {
+		if operand == nil {
+			report("operand %d is nil", i)
+			continue
+		}
+		operand.validate(fmt.Sprintf("%s.operands[%d]", location, i), problems)
+	}
+}
+
+func (b *WhereBuilder) validateValue(kind string, report func(format string, args ...interface{})) {
+	switch b.operator {
+	case Like:
+		if kind != "valueText" && kind != "valueString" {
+			report("operator %s requires a text value, got %s", b.operator, kind)
+		}
+	case WithinGeoRange:
+		if kind != "valueGeoRange" {
+			report("operator %s requires a geo range value, got %s", b.operator, kind)
+		}
+	case IsNull:
+		if kind != "valueBoolean" {
+			report("operator %s requires a boolean value, got %s", b.operator, kind)
+		}
+	default:
+		if kind == "valueGeoRange" {
+			report("operator %s does not accept a geo range value", b.operator)
+		}
+	}
+	count := b.valueCount()
+	switch {
+	case count == 0:
+		report("operator %s requires a value", b.operator)
+	case count > 1 && !b.isContainsOperator():
+		report("operator %s accepts a single value, got %d", b.operator, count)
+	}
+}
+
+// valueKinds returns the names of the values set on the builder
+func (b *WhereBuilder) valueKinds() []string {
+	var kinds []string
+	if b.withValueInt {
+		kinds = append(kinds, "valueInt")
+	}
+	if b.withValueNumber {
+		kinds = append(kinds, "valueNumber")
+	}
+	if b.withValueBoolean {
+		kinds = append(kinds, "valueBoolean")
+	}
+	if len(b.valueString) > 0 {
+		kinds = append(kinds, "valueString")
+	}
+	if len(b.valueText) > 0 {
+		kinds = append(kinds, "valueText")
+	}
+	if b.withValueDate {
+		kinds = append(kinds, "valueDate")
+	}
+	if b.valueGeoRange != nil {
+		kinds = append(kinds, "valueGeoRange")
+	}
+	return kinds
+}
+
+func (b *WhereBuilder) valueCount() int {
+	if b.valueGeoRange != nil {
+		return 1
+	}
+	return len(b.valueInt) + len(b.valueNumber) + len(b.valueBoolean) +
+		len(b.valueString) + len(b.valueText) + len(b.valueDate)
+}
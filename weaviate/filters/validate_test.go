@@ -0,0 +1,93 @@
+package filters
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWhereBuilder_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   *WhereBuilder
+		problems []string
+	}{
+		{
+			name:   "valid filter",
+			filter: Where().WithOperator(Equal).WithPath([]string{"name"}).WithValueText("Hawaii"),
+		},
+		{
+			name:     "missing operator",
+			filter:   Where().WithPath([]string{"name"}).WithValueText("Hawaii"),
+			problems: []string{"where: operator is missing"},
+		},
+		{
+			name: "and with a path",
+			filter: Where().WithOperator(And).WithPath([]string{"name"}).
+				WithOperands([]*WhereBuilder{TextProp("name").Equal("Hawaii")}),
+			problems: []string{"where: operator And does not accept a path"},
+		},
+		{
+			name:     "or without operands",
+			filter:   Where().WithOperator(Or),
+			problems: []string{"where: operator Or requires operands"},
+		},
+		{
+			name: "not with two operands",
+			filter: Where().WithOperator(Not).
+				WithOperands([]*WhereBuilder{TextProp("name").Equal("Hawaii"), TextProp("name").Equal("Doener")}),
+			problems: []string{"where: operator Not requires exactly one operand, got 2"},
+		},
+		{
+			name:     "like on an int",
+			filter:   Where().WithOperator(Like).WithPath([]string{"size"}).WithValueInt(3),
+			problems: []string{"where: operator Like requires a text value, got valueInt"},
+		},
+		{
+			name:     "within geo range without coordinates",
+			filter:   Where().WithOperator(WithinGeoRange).WithPath([]string{"location"}).WithValueNumber(2000),
+			problems: []string{"where: operator WithinGeoRange requires a geo range value, got valueNumber"},
+		},
+		{
+			name:     "equal without value",
+			filter:   Where().WithOperator(Equal).WithPath([]string{"name"}),
+			problems: []string{"where: operator Equal requires a value"},
+		},
+		{
+			name:     "equal with multiple values",
+			filter:   Where().WithOperator(Equal).WithPath([]string{"size"}).WithValueInt(1, 2),
+			problems: []string{"where: operator Equal accepts a single value, got 2"},
+		},
+		{
+			name:     "multiple kinds of values",
+			filter:   Where().WithOperator(Equal).WithPath([]string{"size"}).WithValueInt(1).WithValueText("1"),
+			problems: []string{"where: operator Equal accepts a single kind of value, got valueInt, valueText"},
+		},
+		{
+			name: "problems in operands",
+			filter: AllOf(
+				TextProp("name").Equal("Hawaii"),
+				AnyOf(Where().WithPath([]string{"price"}), Where().WithOperator(IsNull).WithValueText("true")),
+			),
+			problems: []string{
+				"where.operands[1].operands[0]: operator is missing",
+				"where.operands[1].operands[1]: operator IsNull requires a path",
+				"where.operands[1].operands[1]: operator IsNull requires a boolean value, got valueText",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if len(tt.problems) == 0 {
+				assert.Nil(t, err)
+				return
+			}
+			var validationErr *ValidationError
+			require.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, tt.problems, validationErr.Problems)
+		})
+	}
+}
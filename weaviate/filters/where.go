@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -169,14 +170,10 @@ func (b *WhereBuilder) String() string {
 func (b *WhereBuilder) string() string {
 	clause := []string{}
 	if len(b.operator) > 0 {
-		clause = append(clause, fmt.Sprintf("operator: %s", b.operator))
+		clause = append(clause, fmt.Sprintf("operator: %s", gql.Enum(string(b.operator))))
 	}
 	if len(b.path) > 0 {
-		path := make([]string, len(b.path))
-		for i := range b.path {
-			path[i] = fmt.Sprintf("\"%s\"", b.path[i])
-		}
-		clause = append(clause, fmt.Sprintf("path: [%v]", strings.Join(path, ",")))
+		clause = append(clause, fmt.Sprintf("path: %s", gql.QuoteList(b.path)))
 	}
 	if b.withValueInt {
 		clause = append(clause, fmt.Sprintf("valueInt: %s", formatValues(b.valueInt, b.operator)))
@@ -229,9 +226,9 @@ func formatValues[T any](values []T, operator WhereOperator) string {
 	for i, value := range values {
 		switch val := any(value).(type) {
 		case string:
-			clause[i] = fmt.Sprintf("%q", val)
+			clause[i] = gql.Quote(val)
 		case time.Time:
-			clause[i] = fmt.Sprintf("%q", val.Format(time.RFC3339Nano))
+			clause[i] = gql.Quote(val.Format(time.RFC3339Nano))
 		default:
 			clause[i] = fmt.Sprintf("%v", val)
 		}
@@ -6,14 +6,19 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/filters"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
 // AggregateBuilder for the aggregate GraphQL query string
 type AggregateBuilder struct {
 	connection                rest
+	strictErrors              bool
+	queryVariables            bool
 	fields                    []Field
+	aggregations              []Aggregation
 	className                 string
 	includesFilterClause      bool // true if brackets behind class is needed
 	groupByClausePropertyName string
@@ -29,6 +34,8 @@ type AggregateBuilder struct {
 	withNearDepth             *NearDepthArgumentBuilder
 	withNearThermal           *NearThermalArgumentBuilder
 	withNearImu               *NearImuArgumentBuilder
+	withHybrid                *HybridArgumentBuilder
+	withBM25                  *BM25ArgumentBuilder
 	includesObjectLimit       bool
 	objectLimit               int
 	includesLimit             bool
@@ -41,6 +48,13 @@ func (ab *AggregateBuilder) WithFields(fields ...Field) *AggregateBuilder {
 	return ab
 }
 
+// WithAggregations adds typed aggregations to the fields of the query, their results can
+// be decoded with DecodeAggregate
+func (ab *AggregateBuilder) WithAggregations(aggregations ...Aggregation) *AggregateBuilder {
+	ab.aggregations = append(ab.aggregations, aggregations...)
+	return ab
+}
+
 // WithClassName that should be aggregated
 func (ab *AggregateBuilder) WithClassName(name string) *AggregateBuilder {
 	ab.className = name
@@ -84,6 +98,21 @@ func (ab *AggregateBuilder) WithNearVector(nearVector *NearVectorArgumentBuilder
 	return ab
 }
 
+// WithHybrid aggregates the results of a hybrid search, which requires WithObjectLimit
+// unless the search has a maximum vector distance
+func (ab *AggregateBuilder) WithHybrid(hybrid *HybridArgumentBuilder) *AggregateBuilder {
+	ab.includesFilterClause = true
+	ab.withHybrid = hybrid
+	return ab
+}
+
+// WithBM25 aggregates the results of a keyword search
+func (ab *AggregateBuilder) WithBM25(bm25 *BM25ArgumentBuilder) *AggregateBuilder {
+	ab.includesFilterClause = true
+	ab.withBM25 = bm25
+	return ab
+}
+
 // WithObjectLimit specifies max number of vector search results to return
 func (ab *AggregateBuilder) WithObjectLimit(objectLimit int) *AggregateBuilder {
 	ab.objectLimit = objectLimit
@@ -154,30 +183,48 @@ func (ab *AggregateBuilder) WithTenant(tenant string) *AggregateBuilder {
 	return ab
 }
 
+// WithStrictErrors makes Do fail with a *fault.GraphQLError if the GraphQL response contains errors.
+// The partial data of the response is still returned next to the error.
+func (ab *AggregateBuilder) WithStrictErrors(strict bool) *AggregateBuilder {
+	ab.strictErrors = strict
+	return ab
+}
+
+// WithQueryVariables sends the search values as GraphQL variables instead of inlining
+// them into the query string, see GetBuilder.WithQueryVariables
+func (ab *AggregateBuilder) WithQueryVariables(queryVariables bool) *AggregateBuilder {
+	ab.queryVariables = queryVariables
+	return ab
+}
+
 // Do execute the aggregation query
 func (ab *AggregateBuilder) Do(ctx context.Context) (*models.GraphQLResponse, error) {
-	return runGraphQLQuery(ctx, ab.connection, ab.build())
+	if err := ab.validate(); err != nil {
+		return nil, except.NewDerivedWeaviateClientError(err)
+	}
+	return runGraphQLQuery(ctx, ab.connection, ab.buildQuery(), ab.strictErrors)
 }
 
-func (ab *AggregateBuilder) createFilterClause() string {
+func (ab *AggregateBuilder) createFilterClause(vars *gql.Variables) string {
 	if ab.includesFilterClause {
 		filters := []string{}
 		if ab.tenant != "" {
-			filters = append(filters, fmt.Sprintf("tenant: %q", ab.tenant))
+			filters = append(filters, fmt.Sprintf("tenant: %s", vars.Value("String", ab.tenant)))
 		}
 		if len(ab.groupByClausePropertyName) > 0 {
-			filters = append(filters, fmt.Sprintf(`groupBy: "%v"`, ab.groupByClausePropertyName))
+			filters = append(filters, fmt.Sprintf("groupBy: %s", gql.Quote(ab.groupByClausePropertyName)))
 		}
 		if ab.withWhereFilter != nil {
-			filters = append(filters, ab.withWhereFilter.String())
+			filters = append(filters, whereClause(ab.withWhereFilter, vars, "AggregateObjects"+ab.className))
 		}
 		for _, b := range []argumentBuilder{
 			ab.withAsk, ab.withNearTextFilter, ab.withNearObjectFilter, ab.withNearVectorFilter, ab.withNearImage,
 			ab.withNearAudio, ab.withNearVideo, ab.withNearDepth, ab.withNearThermal, ab.withNearImu,
+			ab.withHybrid, ab.withBM25,
 		} {
 			bVal := reflect.ValueOf(b)
 			if bVal.Kind() == reflect.Ptr && !bVal.IsNil() {
-				filters = append(filters, b.build())
+				filters = append(filters, buildArgument(b, vars))
 			}
 		}
 		if ab.includesObjectLimit {
@@ -192,19 +239,99 @@ func (ab *AggregateBuilder) createFilterClause() string {
 }
 
 func (ab *AggregateBuilder) createFieldsClause() string {
-	if len(ab.fields) > 0 {
-		fields := make([]string, len(ab.fields))
-		for i := range ab.fields {
-			fields[i] = ab.fields[i].build()
+	fields := make([]string, 0, len(ab.fields)+len(ab.aggregations))
+	for i := range ab.fields {
+		fields = append(fields, ab.fields[i].build())
+	}
+	for i := range ab.aggregations {
+		fields = append(fields, ab.aggregations[i].Field().build())
+	}
+	return strings.Join(fields, " ")
+}
+
+// validate the parts of the query which can't be escaped
+func (ab *AggregateBuilder) validate() error {
+	if err := gql.ValidateClassName(ab.className); err != nil {
+		return err
+	}
+	if err := validateFields(ab.fields); err != nil {
+		return err
+	}
+	if err := validateAggregations(ab.aggregations); err != nil {
+		return err
+	}
+	if ab.withHybrid != nil {
+		if err := ab.withHybrid.validate(); err != nil {
+			return err
 		}
-		return strings.Join(fields, " ")
 	}
-	return ""
+	if err := ab.validateObjectLimit(); err != nil {
+		return err
+	}
+	if ab.groupByClausePropertyName != "" {
+		return gql.ValidatePropertyName(ab.groupByClausePropertyName)
+	}
+	return nil
+}
+
+// vectorSearch is implemented by the argument builders of vector searches
+type vectorSearch interface {
+	hasThreshold() bool
+}
+
+// validateObjectLimit checks that the objects aggregated by a vector search are limited,
+// either by objectLimit or a threshold of the search, as weaviate otherwise rejects the query
+func (ab *AggregateBuilder) validateObjectLimit() error {
+	searches := []vectorSearch{}
+	for _, b := range []vectorSearch{
+		ab.withAsk, ab.withNearTextFilter, ab.withNearObjectFilter, ab.withNearVectorFilter, ab.withNearImage,
+		ab.withNearAudio, ab.withNearVideo, ab.withNearDepth, ab.withNearThermal, ab.withNearImu, ab.withHybrid,
+	} {
+		bVal := reflect.ValueOf(b)
+		if bVal.Kind() == reflect.Ptr && !bVal.IsNil() {
+			searches = append(searches, b)
+		}
+	}
+	if ab.includesObjectLimit {
+		if ab.objectLimit <= 0 {
+			return fmt.Errorf("objectLimit must be a positive integer")
+		}
+		if len(searches) == 0 && ab.withBM25 == nil {
+			return fmt.Errorf("objectLimit can only be used with a near<Media>, hybrid or bm25 search")
+		}
+		return nil
+	}
+	for _, search := range searches {
+		if !search.hasThreshold() {
+			return fmt.Errorf("objectLimit must be set when aggregating a vector search without certainty or distance")
+		}
+	}
+	return nil
 }
 
 // build the query string
 func (ab *AggregateBuilder) build() string {
-	filterClause := ab.createFilterClause()
+	return ab.buildWith(nil)
+}
+
+// Build the GraphQL query as it is sent by Do, including the variables if enabled
+func (ab *AggregateBuilder) Build() *models.GraphQLQuery {
+	return ab.buildQuery()
+}
+
+// String returns the GraphQL query string as it is sent by Do
+func (ab *AggregateBuilder) String() string {
+	return ab.buildQuery().Query
+}
+
+// buildQuery with the values passed as variables if query variables are enabled
+func (ab *AggregateBuilder) buildQuery() *models.GraphQLQuery {
+	vars := newVariables(ab.queryVariables)
+	return graphQLQuery(ab.buildWith(vars), vars)
+}
+
+func (ab *AggregateBuilder) buildWith(vars *gql.Variables) string {
+	filterClause := ab.createFilterClause(vars)
 	fields := ab.createFieldsClause()
 	return fmt.Sprintf(`{Aggregate{%v%v{%v}}}`, ab.className, filterClause, fields)
 }
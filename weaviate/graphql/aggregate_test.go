@@ -1,9 +1,11 @@
 package graphql
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/filters"
 )
 
@@ -495,6 +497,101 @@ func TestAggregateBuilder(t *testing.T) {
 		})
 	})
 
+	t.Run("hybrid", func(t *testing.T) {
+		builder := AggregateBuilder{connection: &MockRunREST{}}
+		hybrid := (&HybridArgumentBuilder{}).WithQuery("pizza").WithAlpha(0.5).WithTargetVectors("name")
+
+		query := builder.WithClassName("Pizza").WithHybrid(hybrid).WithObjectLimit(10).
+			WithAggregations(MetaAggregation{}).build()
+
+		expected := `{Aggregate{Pizza(hybrid:{query: "pizza", alpha: 0.5, targetVectors: ["name"]}, objectLimit: 10){meta{count}}}}`
+		assert.Equal(t, expected, query)
+	})
+
+	t.Run("bm25", func(t *testing.T) {
+		builder := AggregateBuilder{connection: &MockRunREST{}}
+		bm25 := (&BM25ArgumentBuilder{}).WithQuery("pizza").WithProperties("name")
+
+		query := builder.WithClassName("Pizza").WithBM25(bm25).WithAggregations(MetaAggregation{}).build()
+
+		expected := `{Aggregate{Pizza(bm25:{query: "pizza", properties: ["name"]}){meta{count}}}}`
+		assert.Equal(t, expected, query)
+	})
+
+	t.Run("objectLimit validation", func(t *testing.T) {
+		nearVector := func() *NearVectorArgumentBuilder {
+			return (&NearVectorArgumentBuilder{}).WithVector([]float32{0.1})
+		}
+		tests := []struct {
+			name    string
+			builder func(ab *AggregateBuilder) *AggregateBuilder
+			valid   bool
+		}{
+			{
+				name: "vector search with objectLimit",
+				builder: func(ab *AggregateBuilder) *AggregateBuilder {
+					return ab.WithNearVector(nearVector()).WithObjectLimit(5)
+				},
+				valid: true,
+			},
+			{
+				name:    "vector search with distance",
+				builder: func(ab *AggregateBuilder) *AggregateBuilder { return ab.WithNearVector(nearVector().WithDistance(0.3)) },
+				valid:   true,
+			},
+			{
+				name:    "vector search without limit",
+				builder: func(ab *AggregateBuilder) *AggregateBuilder { return ab.WithNearVector(nearVector()) },
+			},
+			{
+				name: "hybrid without limit",
+				builder: func(ab *AggregateBuilder) *AggregateBuilder {
+					return ab.WithHybrid((&HybridArgumentBuilder{}).WithQuery("pizza"))
+				},
+			},
+			{
+				name: "hybrid with max vector distance",
+				builder: func(ab *AggregateBuilder) *AggregateBuilder {
+					return ab.WithHybrid((&HybridArgumentBuilder{}).WithQuery("pizza").WithMaxVectorDistance(0.5))
+				},
+				valid: true,
+			},
+			{
+				name: "bm25 with objectLimit",
+				builder: func(ab *AggregateBuilder) *AggregateBuilder {
+					return ab.WithBM25((&BM25ArgumentBuilder{}).WithQuery("pizza")).WithObjectLimit(5)
+				},
+				valid: true,
+			},
+			{
+				name:    "objectLimit without search",
+				builder: func(ab *AggregateBuilder) *AggregateBuilder { return ab.WithObjectLimit(5) },
+			},
+			{
+				name: "non positive objectLimit",
+				builder: func(ab *AggregateBuilder) *AggregateBuilder {
+					return ab.WithNearVector(nearVector()).WithObjectLimit(0)
+				},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				conMock := &MockRunREST{
+					ReturnResponseData: &connection.ResponseData{StatusCode: 200, Body: []byte(`{"data":{}}`)},
+				}
+				builder := tt.builder((&AggregateBuilder{connection: conMock}).WithClassName("Pizza").WithAggregations(MetaAggregation{}))
+				_, err := builder.Do(context.Background())
+				if tt.valid {
+					assert.NoError(t, err)
+					assert.Equal(t, "/graphql", conMock.ArgPath)
+				} else {
+					assert.Error(t, err)
+					assert.Empty(t, conMock.ArgPath, "no request is sent")
+				}
+			})
+		}
+	})
+
 	t.Run("Missuse", func(t *testing.T) {
 		conMock := &MockRunREST{}
 
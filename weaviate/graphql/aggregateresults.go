@@ -0,0 +1,156 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AggregateGroup is the result of an Aggregate query, or one group of it if the query
+// is grouped with AggregateBuilder.WithGroupBy
+type AggregateGroup struct {
+	// Meta is set if MetaAggregation was requested
+	Meta *AggregateMeta
+	// GroupedBy is set if GroupedByAggregation was requested
+	GroupedBy *GroupedBy
+	// properties are the aggregations by property name, decoded by the typed getters
+	properties map[string]json.RawMessage
+}
+
+// AggregateMeta of the aggregated objects
+type AggregateMeta struct {
+	Count *int `json:"count"`
+}
+
+// NumberAggregationResult of a NumberAggregation
+type NumberAggregationResult struct {
+	Count   *int     `json:"count"`
+	Type    string   `json:"type"`
+	Minimum *float64 `json:"minimum"`
+	Maximum *float64 `json:"maximum"`
+	Mean    *float64 `json:"mean"`
+	Median  *float64 `json:"median"`
+	Mode    *float64 `json:"mode"`
+	Sum     *float64 `json:"sum"`
+}
+
+// TextAggregationResult of a TextAggregation
+type TextAggregationResult struct {
+	Count          *int             `json:"count"`
+	Type           string           `json:"type"`
+	TopOccurrences []TextOccurrence `json:"topOccurrences"`
+}
+
+// TextOccurrence is a value of a text property and how often it occurs
+type TextOccurrence struct {
+	Value  string `json:"value"`
+	Occurs int    `json:"occurs"`
+}
+
+// BooleanAggregationResult of a BooleanAggregation
+type BooleanAggregationResult struct {
+	Count           *int     `json:"count"`
+	Type            string   `json:"type"`
+	TotalTrue       *int     `json:"totalTrue"`
+	TotalFalse      *int     `json:"totalFalse"`
+	PercentageTrue  *float64 `json:"percentageTrue"`
+	PercentageFalse *float64 `json:"percentageFalse"`
+}
+
+// DateAggregationResult of a DateAggregation, the dates are formatted as RFC 3339
+type DateAggregationResult struct {
+	Count   *int    `json:"count"`
+	Minimum *string `json:"minimum"`
+	Maximum *string `json:"maximum"`
+	Median  *string `json:"median"`
+	Mode    *string `json:"mode"`
+}
+
+// ReferenceAggregationResult of a ReferenceAggregation
+type ReferenceAggregationResult struct {
+	Type       string   `json:"type"`
+	PointingTo []string `json:"pointingTo"`
+}
+
+// UnmarshalJSON decodes meta and groupedBy and keeps the property aggregations for the
+// typed getters
+func (g *AggregateGroup) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if meta, ok := fields["meta"]; ok {
+		if err := json.Unmarshal(meta, &g.Meta); err != nil {
+			return fmt.Errorf("meta: %w", err)
+		}
+		delete(fields, "meta")
+	}
+	if groupedBy, ok := fields["groupedBy"]; ok {
+		if err := json.Unmarshal(groupedBy, &g.GroupedBy); err != nil {
+			return fmt.Errorf("groupedBy: %w", err)
+		}
+		delete(fields, "groupedBy")
+	}
+	g.properties = fields
+	return nil
+}
+
+// Number returns the aggregation of an int or number property
+func (g *AggregateGroup) Number(property string) (*NumberAggregationResult, error) {
+	var result NumberAggregationResult
+	return &result, g.property(property, &result)
+}
+
+// Text returns the aggregation of a text property
+func (g *AggregateGroup) Text(property string) (*TextAggregationResult, error) {
+	var result TextAggregationResult
+	return &result, g.property(property, &result)
+}
+
+// Boolean returns the aggregation of a boolean property
+func (g *AggregateGroup) Boolean(property string) (*BooleanAggregationResult, error) {
+	var result BooleanAggregationResult
+	return &result, g.property(property, &result)
+}
+
+// Date returns the aggregation of a date property
+func (g *AggregateGroup) Date(property string) (*DateAggregationResult, error) {
+	var result DateAggregationResult
+	return &result, g.property(property, &result)
+}
+
+// Reference returns the aggregation of a cross-reference property
+func (g *AggregateGroup) Reference(property string) (*ReferenceAggregationResult, error) {
+	var result ReferenceAggregationResult
+	return &result, g.property(property, &result)
+}
+
+func (g *AggregateGroup) property(property string, target interface{}) error {
+	raw, ok := g.properties[property]
+	if !ok {
+		return fmt.Errorf("no aggregation of property %q in result", property)
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		return fmt.Errorf("decode aggregation of property %q: %w", property, err)
+	}
+	return nil
+}
+
+// DecodeAggregate returns the result of an Aggregate query of className, one group per
+// value if the query is grouped or a single one otherwise
+func DecodeAggregate(response *models.GraphQLResponse, className string) ([]AggregateGroup, error) {
+	aggregate, err := responseData(response, "Aggregate")
+	if err != nil {
+		return nil, err
+	}
+	groups, ok := aggregate[className]
+	if !ok {
+		return nil, fmt.Errorf("decode aggregate: no aggregation of class %q in response", className)
+	}
+	var result []AggregateGroup
+	if err := decodeJSON(groups, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
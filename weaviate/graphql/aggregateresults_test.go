@@ -0,0 +1,67 @@
+package graphql
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestDecodeAggregate(t *testing.T) {
+	var response models.GraphQLResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"data":{"Aggregate":{"Pizza":[
+		{"meta":{"count":3},"groupedBy":{"value":"Italy","path":["origin"]},
+			"price":{"count":3,"type":"number","minimum":5,"maximum":12.5,"mean":8.5,"median":8,"mode":5,"sum":25.5},
+			"name":{"count":3,"type":"text","topOccurrences":[{"value":"Margherita","occurs":2},{"value":"Diavola","occurs":1}]},
+			"vegetarian":{"totalTrue":2,"percentageTrue":0.6666666666666666},
+			"bakedAt":{"minimum":"2023-01-01T00:00:00Z","maximum":"2023-12-31T00:00:00Z"},
+			"ofRestaurant":{"type":"cref","pointingTo":["Restaurant"]}},
+		{"meta":{"count":1},"groupedBy":{"value":"USA","path":["origin"]},"price":{"mean":9}}
+	]}}}`), &response))
+
+	groups, err := DecodeAggregate(&response, "Pizza")
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+
+	group := groups[0]
+	assert.Equal(t, 3, *group.Meta.Count)
+	assert.Equal(t, &GroupedBy{Value: "Italy", Path: []string{"origin"}}, group.GroupedBy)
+
+	price, err := group.Number("price")
+	require.NoError(t, err)
+	assert.Equal(t, "number", price.Type)
+	assert.Equal(t, 12.5, *price.Maximum)
+	assert.Equal(t, 25.5, *price.Sum)
+
+	name, err := group.Text("name")
+	require.NoError(t, err)
+	assert.Equal(t, []TextOccurrence{{Value: "Margherita", Occurs: 2}, {Value: "Diavola", Occurs: 1}}, name.TopOccurrences)
+
+	vegetarian, err := group.Boolean("vegetarian")
+	require.NoError(t, err)
+	assert.Equal(t, 2, *vegetarian.TotalTrue)
+	assert.Nil(t, vegetarian.TotalFalse)
+
+	bakedAt, err := group.Date("bakedAt")
+	require.NoError(t, err)
+	assert.Equal(t, "2023-12-31T00:00:00Z", *bakedAt.Maximum)
+
+	ofRestaurant, err := group.Reference("ofRestaurant")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Restaurant"}, ofRestaurant.PointingTo)
+
+	price, err = groups[1].Number("price")
+	require.NoError(t, err)
+	assert.Equal(t, 9.0, *price.Mean)
+	assert.Nil(t, price.Sum)
+
+	_, err = groups[1].Text("name")
+	assert.Error(t, err)
+
+	t.Run("missing class", func(t *testing.T) {
+		_, err := DecodeAggregate(&response, "Soup")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,273 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
+)
+
+// Aggregation of a property or of the aggregated objects, see AggregateBuilder.WithAggregations
+type Aggregation interface {
+	Field() Field
+}
+
+// propertyAggregation is implemented by the aggregations of a single property
+type propertyAggregation interface {
+	propertyName() string
+}
+
+// MetaAggregation requests the number of aggregated objects, meta{count}
+type MetaAggregation struct{}
+
+// Field of the aggregation
+func (MetaAggregation) Field() Field {
+	return Field{Name: "meta", Fields: []Field{{Name: "count"}}}
+}
+
+// GroupedByAggregation requests the value and path a group of an aggregation with
+// AggregateBuilder.WithGroupBy was formed by, groupedBy{value path}
+type GroupedByAggregation struct{}
+
+// Field of the aggregation
+func (GroupedByAggregation) Field() Field {
+	return Field{Name: "groupedBy", Fields: []Field{{Name: "value"}, {Name: "path"}}}
+}
+
+// metricsAggregation is the common part of the property aggregations, which request
+// all metrics of their data type if none are selected
+type metricsAggregation struct {
+	property string
+	metrics  []Field
+}
+
+func (a *metricsAggregation) add(metric string) {
+	a.metrics = append(a.metrics, Field{Name: metric})
+}
+
+func (a *metricsAggregation) field(all ...string) Field {
+	metrics := a.metrics
+	if len(metrics) == 0 {
+		for _, metric := range all {
+			metrics = append(metrics, Field{Name: metric})
+		}
+	}
+	return Field{Name: a.property, Fields: metrics}
+}
+
+func (a *metricsAggregation) propertyName() string {
+	return a.property
+}
+
+// NumberAggregation of an int or number property
+type NumberAggregation struct {
+	metricsAggregation
+}
+
+// NewNumberAggregation of property, without any metric selected all are requested
+func NewNumberAggregation(property string) *NumberAggregation {
+	return &NumberAggregation{metricsAggregation{property: property}}
+}
+
+// WithCount of the values
+func (a *NumberAggregation) WithCount() *NumberAggregation {
+	a.add("count")
+	return a
+}
+
+// WithMinimum of the values
+func (a *NumberAggregation) WithMinimum() *NumberAggregation {
+	a.add("minimum")
+	return a
+}
+
+// WithMaximum of the values
+func (a *NumberAggregation) WithMaximum() *NumberAggregation {
+	a.add("maximum")
+	return a
+}
+
+// WithMean of the values
+func (a *NumberAggregation) WithMean() *NumberAggregation {
+	a.add("mean")
+	return a
+}
+
+// WithMedian of the values
+func (a *NumberAggregation) WithMedian() *NumberAggregation {
+	a.add("median")
+	return a
+}
+
+// WithMode of the values
+func (a *NumberAggregation) WithMode() *NumberAggregation {
+	a.add("mode")
+	return a
+}
+
+// WithSum of the values
+func (a *NumberAggregation) WithSum() *NumberAggregation {
+	a.add("sum")
+	return a
+}
+
+// Field of the aggregation
+func (a *NumberAggregation) Field() Field {
+	return a.field("count", "type", "minimum", "maximum", "mean", "median", "mode", "sum")
+}
+
+// TextAggregation of a text property
+type TextAggregation struct {
+	metricsAggregation
+}
+
+// NewTextAggregation of property, without any metric selected all are requested
+func NewTextAggregation(property string) *TextAggregation {
+	return &TextAggregation{metricsAggregation{property: property}}
+}
+
+// WithCount of the values
+func (a *TextAggregation) WithCount() *TextAggregation {
+	a.add("count")
+	return a
+}
+
+// WithTopOccurrences requests the most frequent values with their number of occurrences.
+// A limit of 0 uses weaviate's default.
+func (a *TextAggregation) WithTopOccurrences(limit int) *TextAggregation {
+	a.metrics = append(a.metrics, topOccurrences(limit))
+	return a
+}
+
+// Field of the aggregation
+func (a *TextAggregation) Field() Field {
+	field := a.field("count", "type")
+	if len(a.metrics) == 0 {
+		field.Fields = append(field.Fields, topOccurrences(0))
+	}
+	return field
+}
+
+func topOccurrences(limit int) Field {
+	name := "topOccurrences"
+	if limit > 0 {
+		name = fmt.Sprintf("topOccurrences(limit:%d)", limit)
+	}
+	return Field{Name: name, Fields: []Field{{Name: "value"}, {Name: "occurs"}}}
+}
+
+// BooleanAggregation of a boolean property
+type BooleanAggregation struct {
+	metricsAggregation
+}
+
+// NewBooleanAggregation of property, without any metric selected all are requested
+func NewBooleanAggregation(property string) *BooleanAggregation {
+	return &BooleanAggregation{metricsAggregation{property: property}}
+}
+
+// WithCount of the values
+func (a *BooleanAggregation) WithCount() *BooleanAggregation {
+	a.add("count")
+	return a
+}
+
+// WithTotalTrue number of true values
+func (a *BooleanAggregation) WithTotalTrue() *BooleanAggregation {
+	a.add("totalTrue")
+	return a
+}
+
+// WithTotalFalse number of false values
+func (a *BooleanAggregation) WithTotalFalse() *BooleanAggregation {
+	a.add("totalFalse")
+	return a
+}
+
+// WithPercentageTrue share of true values
+func (a *BooleanAggregation) WithPercentageTrue() *BooleanAggregation {
+	a.add("percentageTrue")
+	return a
+}
+
+// WithPercentageFalse share of false values
+func (a *BooleanAggregation) WithPercentageFalse() *BooleanAggregation {
+	a.add("percentageFalse")
+	return a
+}
+
+// Field of the aggregation
+func (a *BooleanAggregation) Field() Field {
+	return a.field("count", "type", "totalTrue", "totalFalse", "percentageTrue", "percentageFalse")
+}
+
+// DateAggregation of a date property
+type DateAggregation struct {
+	metricsAggregation
+}
+
+// NewDateAggregation of property, without any metric selected all are requested
+func NewDateAggregation(property string) *DateAggregation {
+	return &DateAggregation{metricsAggregation{property: property}}
+}
+
+// WithCount of the values
+func (a *DateAggregation) WithCount() *DateAggregation {
+	a.add("count")
+	return a
+}
+
+// WithMinimum earliest date
+func (a *DateAggregation) WithMinimum() *DateAggregation {
+	a.add("minimum")
+	return a
+}
+
+// WithMaximum latest date
+func (a *DateAggregation) WithMaximum() *DateAggregation {
+	a.add("maximum")
+	return a
+}
+
+// WithMedian of the dates
+func (a *DateAggregation) WithMedian() *DateAggregation {
+	a.add("median")
+	return a
+}
+
+// WithMode of the dates
+func (a *DateAggregation) WithMode() *DateAggregation {
+	a.add("mode")
+	return a
+}
+
+// Field of the aggregation
+func (a *DateAggregation) Field() Field {
+	return a.field("count", "minimum", "maximum", "median", "mode")
+}
+
+// ReferenceAggregation of a cross-reference property, requests the classes it points to
+type ReferenceAggregation struct {
+	metricsAggregation
+}
+
+// NewReferenceAggregation of property
+func NewReferenceAggregation(property string) *ReferenceAggregation {
+	return &ReferenceAggregation{metricsAggregation{property: property}}
+}
+
+// Field of the aggregation
+func (a *ReferenceAggregation) Field() Field {
+	return a.field("type", "pointingTo")
+}
+
+// validateAggregations checks the names of the aggregated properties, which can't be escaped
+func validateAggregations(aggregations []Aggregation) error {
+	for _, aggregation := range aggregations {
+		if property, ok := aggregation.(propertyAggregation); ok {
+			if err := gql.ValidatePropertyName(property.propertyName()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregations(t *testing.T) {
+	tests := []struct {
+		name        string
+		aggregation Aggregation
+		want        string
+	}{
+		{name: "meta", aggregation: MetaAggregation{}, want: "meta{count}"},
+		{name: "groupedBy", aggregation: GroupedByAggregation{}, want: "groupedBy{value path}"},
+		{
+			name:        "all number metrics",
+			aggregation: NewNumberAggregation("price"),
+			want:        "price{count type minimum maximum mean median mode sum}",
+		},
+		{
+			name:        "selected number metrics",
+			aggregation: NewNumberAggregation("price").WithMean().WithMaximum(),
+			want:        "price{mean maximum}",
+		},
+		{
+			name:        "all text metrics",
+			aggregation: NewTextAggregation("name"),
+			want:        "name{count type topOccurrences{value occurs}}",
+		},
+		{
+			name:        "text top occurrences with limit",
+			aggregation: NewTextAggregation("name").WithTopOccurrences(3),
+			want:        "name{topOccurrences(limit:3){value occurs}}",
+		},
+		{
+			name:        "selected boolean metrics",
+			aggregation: NewBooleanAggregation("vegetarian").WithTotalTrue().WithPercentageTrue(),
+			want:        "vegetarian{totalTrue percentageTrue}",
+		},
+		{
+			name:        "all date metrics",
+			aggregation: NewDateAggregation("bakedAt"),
+			want:        "bakedAt{count minimum maximum median mode}",
+		},
+		{
+			name:        "reference",
+			aggregation: NewReferenceAggregation("ofRestaurant"),
+			want:        "ofRestaurant{type pointingTo}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.aggregation.Field().build())
+		})
+	}
+
+	t.Run("in aggregate query", func(t *testing.T) {
+		builder := AggregateBuilder{connection: &MockRunREST{}}
+		query := builder.WithClassName("Pizza").WithGroupBy("origin").
+			WithAggregations(MetaAggregation{}, GroupedByAggregation{}, NewNumberAggregation("price").WithMean()).
+			build()
+
+		expected := `{Aggregate{Pizza(groupBy: "origin"){meta{count} groupedBy{value path} price{mean}}}}`
+		assert.Equal(t, expected, query)
+	})
+
+	t.Run("invalid property name", func(t *testing.T) {
+		builder := AggregateBuilder{connection: &MockRunREST{}}
+		_, err := builder.WithClassName("Pizza").
+			WithAggregations(NewNumberAggregation("price}} evil{")).
+			Do(context.Background())
+		assert.Error(t, err)
+	})
+}
@@ -1,9 +1,10 @@
 package graphql
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 )
 
 type AskArgumentBuilder struct {
@@ -59,15 +60,23 @@ func (e *AskArgumentBuilder) WithRerank(rerank bool) *AskArgumentBuilder {
 	return e
 }
 
+// hasThreshold reports whether the search is limited by certainty or distance
+func (e *AskArgumentBuilder) hasThreshold() bool {
+	return e.withCertainty || e.withDistance
+}
+
 // Build build the given clause
 func (e *AskArgumentBuilder) build() string {
+	return e.buildWith(nil)
+}
+
+func (e *AskArgumentBuilder) buildWith(vars *gql.Variables) string {
 	clause := []string{}
 	if len(e.question) > 0 {
-		clause = append(clause, fmt.Sprintf("question: \"%s\"", e.question))
+		clause = append(clause, fmt.Sprintf("question: %s", vars.Value("String!", e.question)))
 	}
 	if len(e.properties) > 0 {
-		properties, _ := json.Marshal(e.properties)
-		clause = append(clause, fmt.Sprintf("properties: %v", string(properties)))
+		clause = append(clause, fmt.Sprintf("properties: %s", gql.QuoteList(e.properties)))
 	}
 	if e.withCertainty {
 		clause = append(clause, fmt.Sprintf("certainty: %v", e.certainty))
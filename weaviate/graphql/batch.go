@@ -0,0 +1,87 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/fault"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Query is a GraphQL query builder which can be executed in a batch,
+// e.g. a *GetBuilder, *AggregateBuilder or *Explore
+type Query interface {
+	Build() *models.GraphQLQuery
+}
+
+// validatingQuery is implemented by query builders which validate their input before being sent
+type validatingQuery interface {
+	validate() error
+}
+
+// BatchResult of a single query of a batch
+type BatchResult struct {
+	// Response of weaviate to the query, nil if the query was not sent because it is invalid
+	Response *models.GraphQLResponse
+	// Err is a *fault.GraphQLError if the response contains errors or the validation error
+	// of the query, nil if the query succeeded
+	Err error
+}
+
+// BatchBuilder to send multiple GraphQL queries in a single request
+type BatchBuilder struct {
+	connection rest
+	queries    []Query
+}
+
+// WithQueries adds the queries to the batch
+func (b *BatchBuilder) WithQueries(queries ...Query) *BatchBuilder {
+	b.queries = append(b.queries, queries...)
+	return b
+}
+
+// Do sends all queries of the batch to weaviate in one request. The results are aligned with
+// the order in which the queries were added. Errors of single queries are reported in their
+// BatchResult, the returned error is only set if the whole request failed.
+func (b *BatchBuilder) Do(ctx context.Context) ([]BatchResult, error) {
+	results := make([]BatchResult, len(b.queries))
+	queries := make(models.GraphQLQueries, 0, len(b.queries))
+	sent := make([]int, 0, len(b.queries))
+	for i, query := range b.queries {
+		if validating, ok := query.(validatingQuery); ok {
+			if err := validating.validate(); err != nil {
+				results[i].Err = except.NewDerivedWeaviateClientError(err)
+				continue
+			}
+		}
+		queries = append(queries, query.Build())
+		sent = append(sent, i)
+	}
+	if len(queries) == 0 {
+		return results, nil
+	}
+
+	responseData, responseErr := b.connection.RunREST(ctx, "/graphql/batch", http.MethodPost, queries)
+	err := except.CheckResponseDataErrorAndStatusCode(responseData, responseErr, 200)
+	if err != nil {
+		return nil, except.NewDerivedWeaviateClientError(err)
+	}
+	var responses models.GraphQLResponses
+	if err := responseData.DecodeBodyIntoTarget(&responses); err != nil {
+		return nil, except.NewDerivedWeaviateClientError(err)
+	}
+	if len(responses) != len(queries) {
+		return nil, except.NewDerivedWeaviateClientError(
+			fmt.Errorf("sent %d queries, but received %d responses", len(queries), len(responses)))
+	}
+	for i, response := range responses {
+		result := &results[sent[i]]
+		result.Response = response
+		if response != nil && len(response.Errors) > 0 {
+			result.Err = &fault.GraphQLError{Errors: response.Errors}
+		}
+	}
+	return results, nil
+}
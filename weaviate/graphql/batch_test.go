@@ -0,0 +1,90 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/fault"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestBatch(t *testing.T) {
+	api := &API{}
+	get := api.Get().WithClassName("Pizza").WithFields(Field{Name: "name"})
+	invalid := api.Get().WithClassName("pizza {name}}").WithFields(Field{Name: "name"})
+	aggregate := api.Aggregate().WithClassName("Pizza").WithFields(Field{Name: "meta{count}"})
+	explore := api.Explore().WithFields(Beacon).WithQueryVariables(true).
+		WithNearText((&NearTextArgumentBuilder{}).WithConcepts([]string{"pizza"}))
+
+	t.Run("results are aligned with the queries", func(t *testing.T) {
+		conMock := &MockRunREST{
+			ReturnResponseData: &connection.ResponseData{
+				StatusCode: 200,
+				Body: []byte(`[` +
+					`{"data":{"Get":{"Pizza":[{"name":"Hawaii"}]}}},` +
+					`{"data":{"Aggregate":{"Pizza":null}},"errors":[{"message":"class not found"}]},` +
+					`{"data":{"Explore":[]}}]`),
+			},
+		}
+		batch := api.Batch().WithQueries(get, invalid, aggregate).WithQueries(explore)
+		batch.connection = conMock
+
+		results, err := batch.Do(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "/graphql/batch", conMock.ArgPath)
+		assert.Equal(t, models.GraphQLQueries{get.Build(), aggregate.Build(), explore.Build()}, conMock.ArgRequestBody)
+
+		require.Len(t, results, 4)
+		assert.NoError(t, results[0].Err)
+		assert.NotNil(t, results[0].Response.Data["Get"])
+
+		assert.Nil(t, results[1].Response)
+		var clientErr *fault.WeaviateClientError
+		require.True(t, errors.As(results[1].Err, &clientErr))
+		assert.Contains(t, clientErr.DerivedFromError.Error(), "invalid class name")
+
+		var gqlErr *fault.GraphQLError
+		require.True(t, errors.As(results[2].Err, &gqlErr))
+		assert.Equal(t, []string{"class not found"}, gqlErr.Messages())
+		assert.NotNil(t, results[2].Response)
+
+		assert.NoError(t, results[3].Err)
+		assert.NotNil(t, results[3].Response.Data["Explore"])
+	})
+
+	t.Run("mismatching number of responses", func(t *testing.T) {
+		conMock := &MockRunREST{
+			ReturnResponseData: &connection.ResponseData{StatusCode: 200, Body: []byte(`[{"data":{}}]`)},
+		}
+		batch := api.Batch().WithQueries(get, aggregate)
+		batch.connection = conMock
+
+		_, err := batch.Do(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed request", func(t *testing.T) {
+		conMock := &MockRunREST{
+			ReturnResponseData: &connection.ResponseData{StatusCode: 500, Body: []byte(`{"error":[{"message":"internal"}]}`)},
+		}
+		batch := api.Batch().WithQueries(get)
+		batch.connection = conMock
+
+		_, err := batch.Do(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		var queries []*models.GraphQLQuery
+		dryRun := api.WithDryRun(func(query *models.GraphQLQuery) { queries = append(queries, query) })
+
+		results, err := dryRun.Batch().WithQueries(get, aggregate).Do(context.Background())
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, []*models.GraphQLQuery{get.Build(), aggregate.Build()}, queries)
+	})
+}
@@ -1,14 +1,42 @@
 package graphql
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/schema"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
+type SearchOperatorType string
+
+// SearchOperatorAnd requires all tokens of the query to match
+const SearchOperatorAnd SearchOperatorType = "And"
+
+// SearchOperatorOr requires at least MinimumOrTokensMatch tokens of the query to match
+const SearchOperatorOr SearchOperatorType = "Or"
+
+// SearchOperator defines how the tokens of a keyword search query are combined
+type SearchOperator struct {
+	Operator SearchOperatorType
+	// MinimumOrTokensMatch is the number of tokens which must match with SearchOperatorOr
+	MinimumOrTokensMatch int
+}
+
+func (o SearchOperator) build() string {
+	clause := []string{fmt.Sprintf("operator:%s", gql.Enum(string(o.Operator)))}
+	if o.MinimumOrTokensMatch > 0 {
+		clause = append(clause, fmt.Sprintf("minimumOrTokensMatch:%v", o.MinimumOrTokensMatch))
+	}
+	return fmt.Sprintf("{%s}", strings.Join(clause, " "))
+}
+
 type BM25ArgumentBuilder struct {
-	query      string
-	properties []string
+	query          string
+	properties     []string
+	searchOperator *SearchOperator
 }
 
 // WithQuery the search string
@@ -23,18 +51,93 @@ func (b *BM25ArgumentBuilder) WithProperties(properties ...string) *BM25Argument
 	return b
 }
 
+// WithBoostedProperty adds a property to search whose score is multiplied by boost,
+// e.g. title with boost 2 is searched as title^2
+func (b *BM25ArgumentBuilder) WithBoostedProperty(property string, boost float32) *BM25ArgumentBuilder {
+	b.properties = append(b.properties, fmt.Sprintf("%s^%v", property, boost))
+	return b
+}
+
+// WithSearchOperator defines how the tokens of the query are combined, by default an object
+// matches if any token matches
+func (b *BM25ArgumentBuilder) WithSearchOperator(operator SearchOperator) *BM25ArgumentBuilder {
+	b.searchOperator = &operator
+	return b
+}
+
+// Validate checks that the searched properties exist in the class and have a searchable
+// inverted index, according to the schema fetched with getter
+func (b *BM25ArgumentBuilder) Validate(ctx context.Context, getter *schema.Getter, className string) error {
+	dump, err := getter.Do(ctx)
+	if err != nil {
+		return err
+	}
+	for _, class := range dump.Classes {
+		if class.Class == className {
+			return b.validateClass(class)
+		}
+	}
+	return fmt.Errorf("validate bm25: class %q not found in schema", className)
+}
+
+func (b *BM25ArgumentBuilder) validateClass(class *models.Class) error {
+	problems := []string{}
+	for _, property := range b.properties {
+		name, _, _ := strings.Cut(property, "^")
+		prop := findProperty(class, name)
+		if prop == nil {
+			problems = append(problems, fmt.Sprintf("property %q does not exist", name))
+		} else if !isIndexSearchable(prop) {
+			problems = append(problems, fmt.Sprintf("property %q is not indexSearchable", name))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("validate bm25 on class %q: %s", class.Class, strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+func findProperty(class *models.Class, name string) *models.Property {
+	for _, prop := range class.Properties {
+		if prop.Name == name {
+			return prop
+		}
+	}
+	return nil
+}
+
+// isIndexSearchable returns whether the property has a searchable inverted index, which only
+// text properties have and which is enabled by default
+func isIndexSearchable(prop *models.Property) bool {
+	if len(prop.DataType) == 0 {
+		return false
+	}
+	switch prop.DataType[0] {
+	case "text", "text[]", "string", "string[]":
+	default:
+		return false
+	}
+	if prop.IndexSearchable != nil {
+		return *prop.IndexSearchable
+	}
+	return prop.IndexInverted == nil || *prop.IndexInverted
+}
+
 // Build build the given clause
 func (b *BM25ArgumentBuilder) build() string {
+	return b.buildWith(nil)
+}
+
+func (b *BM25ArgumentBuilder) buildWith(vars *gql.Variables) string {
 	clause := []string{}
 	if b.query != "" {
-		clause = append(clause, fmt.Sprintf("query: %q", b.query))
+		clause = append(clause, fmt.Sprintf("query: %s", vars.Value("String", b.query)))
 	}
 	if len(b.properties) > 0 {
-		propStr, err := json.Marshal(b.properties)
-		if err != nil {
-			panic(fmt.Errorf("failed to unmarshal bm25 properties: %s", err))
-		}
-		clause = append(clause, fmt.Sprintf("properties: %v", string(propStr)))
+		clause = append(clause, fmt.Sprintf("properties: %s", gql.QuoteList(b.properties)))
+	}
+	if b.searchOperator != nil {
+		clause = append(clause, fmt.Sprintf("searchOperator: %s", b.searchOperator.build()))
 	}
 	return fmt.Sprintf("bm25:{%v}", strings.Join(clause, ", "))
 }
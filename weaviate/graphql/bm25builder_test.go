@@ -1,9 +1,16 @@
 package graphql
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/schema"
 )
 
 func TestBM25Builder_build(t *testing.T) {
@@ -27,4 +34,52 @@ func TestBM25Builder_build(t *testing.T) {
 		expected := `bm25:{query: "\"I'm a complex string\" says the string"}`
 		require.Equal(t, expected, str)
 	})
+
+	t.Run("boosted properties and search operator", func(t *testing.T) {
+		bm25 := BM25ArgumentBuilder{}
+		str := bm25.WithQuery("query").WithProperties("document").WithBoostedProperty("title", 2.5).
+			WithSearchOperator(SearchOperator{Operator: SearchOperatorAnd}).build()
+		expected := `bm25:{query: "query", properties: ["document","title^2.5"], searchOperator: {operator:And}}`
+		require.Equal(t, expected, str)
+	})
+}
+
+func TestBM25Builder_Validate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/schema", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"classes":[{"class":"Article","properties":[` +
+			`{"name":"title","dataType":["text"]},` +
+			`{"name":"tags","dataType":["text[]"],"indexSearchable":true},` +
+			`{"name":"code","dataType":["text"],"indexSearchable":false},` +
+			`{"name":"legacy","dataType":["string"],"indexInverted":false},` +
+			`{"name":"wordCount","dataType":["int"]}` +
+			`]}]}`))
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+	getter := schema.New(connection.NewConnection("http", strings.TrimPrefix(s.URL, "http://"), nil, nil)).Getter()
+
+	t.Run("searchable properties", func(t *testing.T) {
+		bm25 := (&BM25ArgumentBuilder{}).WithProperties("title", "tags").WithBoostedProperty("title", 2)
+		assert.NoError(t, bm25.Validate(context.Background(), getter, "Article"))
+	})
+
+	t.Run("all properties", func(t *testing.T) {
+		bm25 := (&BM25ArgumentBuilder{}).WithQuery("query")
+		assert.NoError(t, bm25.Validate(context.Background(), getter, "Article"))
+	})
+
+	t.Run("unsearchable properties", func(t *testing.T) {
+		bm25 := (&BM25ArgumentBuilder{}).WithProperties("title", "code", "legacy", "wordCount", "missing")
+		err := bm25.Validate(context.Background(), getter, "Article")
+		require.Error(t, err)
+		assert.Equal(t, `validate bm25 on class "Article": property "code" is not indexSearchable, `+
+			`property "legacy" is not indexSearchable, property "wordCount" is not indexSearchable, `+
+			`property "missing" does not exist`, err.Error())
+	})
+
+	t.Run("missing class", func(t *testing.T) {
+		bm25 := (&BM25ArgumentBuilder{}).WithProperties("title")
+		assert.Error(t, bm25.Validate(context.Background(), getter, "Pizza"))
+	})
 }
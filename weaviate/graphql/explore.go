@@ -6,12 +6,16 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
 // Explore query builder
 type Explore struct {
 	connection           rest
+	strictErrors         bool
+	queryVariables       bool
 	includesFilterClause bool // true if brackets behind class is needed
 	includesLimit        bool
 	limit                int
@@ -122,7 +126,7 @@ func (e *Explore) WithOffset(offset int) *Explore {
 	return e
 }
 
-func (e *Explore) createFilterClause() string {
+func (e *Explore) createFilterClause(vars *gql.Variables) string {
 	if e.includesFilterClause {
 		filters := []string{}
 		for _, b := range []argumentBuilder{
@@ -131,7 +135,7 @@ func (e *Explore) createFilterClause() string {
 		} {
 			bVal := reflect.ValueOf(b)
 			if bVal.Kind() == reflect.Ptr && !bVal.IsNil() {
-				filters = append(filters, b.build())
+				filters = append(filters, buildArgument(b, vars))
 			}
 		}
 		if e.includesLimit {
@@ -147,19 +151,66 @@ func (e *Explore) createFilterClause() string {
 
 // build query
 func (e *Explore) build() string {
+	return e.buildWith(nil)
+}
+
+// Build the GraphQL query as it is sent by Do, including the variables if enabled
+func (e *Explore) Build() *models.GraphQLQuery {
+	return e.buildQuery()
+}
+
+// String returns the GraphQL query string as it is sent by Do
+func (e *Explore) String() string {
+	return e.buildQuery().Query
+}
+
+// buildQuery with the values passed as variables if query variables are enabled
+func (e *Explore) buildQuery() *models.GraphQLQuery {
+	vars := newVariables(e.queryVariables)
+	return graphQLQuery(e.buildWith(vars), vars)
+}
+
+func (e *Explore) buildWith(vars *gql.Variables) string {
 	fields := ""
 	for _, field := range e.fields {
 		fields += fmt.Sprintf("%v ", field)
 	}
 
-	filterClause := e.createFilterClause()
+	filterClause := e.createFilterClause(vars)
 
 	query := fmt.Sprintf("{Explore%v{%v}}", filterClause, fields)
 
 	return query
 }
 
+// WithStrictErrors makes Do fail with a *fault.GraphQLError if the GraphQL response contains errors.
+// The partial data of the response is still returned next to the error.
+func (e *Explore) WithStrictErrors(strict bool) *Explore {
+	e.strictErrors = strict
+	return e
+}
+
+// WithQueryVariables sends the search values as GraphQL variables instead of inlining
+// them into the query string, see GetBuilder.WithQueryVariables
+func (e *Explore) WithQueryVariables(queryVariables bool) *Explore {
+	e.queryVariables = queryVariables
+	return e
+}
+
 // Do execute explore search
 func (e *Explore) Do(ctx context.Context) (*models.GraphQLResponse, error) {
-	return runGraphQLQuery(ctx, e.connection, e.build())
+	if err := e.validate(); err != nil {
+		return nil, except.NewDerivedWeaviateClientError(err)
+	}
+	return runGraphQLQuery(ctx, e.connection, e.buildQuery(), e.strictErrors)
+}
+
+// validate the parts of the query which can't be escaped
+func (e *Explore) validate() error {
+	for _, field := range e.fields {
+		if !gql.IsName(string(field)) {
+			return fmt.Errorf("invalid explore field %q", field)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,366 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// FacetedSearchBuilder runs a Get query together with a grouped Aggregate query per facet
+// property, which all share the same filter and search, so that the facet counts match
+// the searched objects
+type FacetedSearchBuilder struct {
+	connection    rest
+	className     string
+	fields        []Field
+	tenant        string
+	where         *filters.WhereBuilder
+	nearText      *NearTextArgumentBuilder
+	nearVector    *NearVectorArgumentBuilder
+	nearObject    *NearObjectArgumentBuilder
+	hybrid        *HybridArgumentBuilder
+	bm25          *BM25ArgumentBuilder
+	includesLimit bool
+	limit         int
+	facets        []string
+	facetLimit    int
+	batch         bool
+	dryRun        bool
+}
+
+// FacetedSearchResult of a faceted search
+type FacetedSearchResult struct {
+	// Response of the Get query, the objects can be decoded with DecodeObjects
+	Response *models.GraphQLResponse
+	// Facets are the buckets of each facet property, ordered as returned by weaviate
+	Facets map[string][]FacetBucket
+
+	className string
+}
+
+// FacetBucket is a value of a facet property and the number of searched objects having it
+type FacetBucket struct {
+	Value string
+	Count int
+}
+
+// DecodeObjects decodes the objects of the Get query into target, see DecodeObjects
+func (r *FacetedSearchResult) DecodeObjects(target interface{}) error {
+	return DecodeObjects(r.Response, r.className, target)
+}
+
+// WithClassName that is searched
+func (fb *FacetedSearchBuilder) WithClassName(name string) *FacetedSearchBuilder {
+	fb.className = name
+	return fb
+}
+
+// WithFields of the searched objects
+func (fb *FacetedSearchBuilder) WithFields(fields ...Field) *FacetedSearchBuilder {
+	fb.fields = fields
+	return fb
+}
+
+// WithTenant the searched objects belong to
+func (fb *FacetedSearchBuilder) WithTenant(tenant string) *FacetedSearchBuilder {
+	fb.tenant = tenant
+	return fb
+}
+
+// WithWhere filter of the search and the facets
+func (fb *FacetedSearchBuilder) WithWhere(where *filters.WhereBuilder) *FacetedSearchBuilder {
+	fb.where = where
+	return fb
+}
+
+// WithNearText search
+func (fb *FacetedSearchBuilder) WithNearText(nearText *NearTextArgumentBuilder) *FacetedSearchBuilder {
+	fb.nearText = nearText
+	return fb
+}
+
+// WithNearVector search
+func (fb *FacetedSearchBuilder) WithNearVector(nearVector *NearVectorArgumentBuilder) *FacetedSearchBuilder {
+	fb.nearVector = nearVector
+	return fb
+}
+
+// WithNearObject search
+func (fb *FacetedSearchBuilder) WithNearObject(nearObject *NearObjectArgumentBuilder) *FacetedSearchBuilder {
+	fb.nearObject = nearObject
+	return fb
+}
+
+// WithHybrid search
+func (fb *FacetedSearchBuilder) WithHybrid(hybrid *HybridArgumentBuilder) *FacetedSearchBuilder {
+	fb.hybrid = hybrid
+	return fb
+}
+
+// WithBM25 search
+func (fb *FacetedSearchBuilder) WithBM25(bm25 *BM25ArgumentBuilder) *FacetedSearchBuilder {
+	fb.bm25 = bm25
+	return fb
+}
+
+// WithLimit of the returned objects. The facets are counted on the same objects by using the
+// limit as objectLimit of the facet queries, which requires a near<Media>, hybrid or bm25
+// search. With only a where filter the facets count all objects matching the filter, so
+// a limit is rejected. A vector search without certainty or distance requires a limit.
+func (fb *FacetedSearchBuilder) WithLimit(limit int) *FacetedSearchBuilder {
+	fb.includesLimit = true
+	fb.limit = limit
+	return fb
+}
+
+// WithFacets adds properties whose values are counted
+func (fb *FacetedSearchBuilder) WithFacets(properties ...string) *FacetedSearchBuilder {
+	fb.facets = append(fb.facets, properties...)
+	return fb
+}
+
+// WithFacetLimit is the maximum number of buckets per facet
+func (fb *FacetedSearchBuilder) WithFacetLimit(limit int) *FacetedSearchBuilder {
+	fb.facetLimit = limit
+	return fb
+}
+
+// WithBatch sends all queries in a single request to the GraphQL batch endpoint instead
+// of running them concurrently
+func (fb *FacetedSearchBuilder) WithBatch(batch bool) *FacetedSearchBuilder {
+	fb.batch = batch
+	return fb
+}
+
+// getQuery returns the Get query of the search
+func (fb *FacetedSearchBuilder) getQuery() *GetBuilder {
+	get := (&GetBuilder{connection: fb.connection, strictErrors: true}).
+		WithClassName(fb.className).WithFields(fb.fields...)
+	if fb.tenant != "" {
+		get.WithTenant(fb.tenant)
+	}
+	if fb.where != nil {
+		get.WithWhere(fb.where)
+	}
+	if fb.nearText != nil {
+		get.WithNearText(fb.nearText)
+	}
+	if fb.nearVector != nil {
+		get.WithNearVector(fb.nearVector)
+	}
+	if fb.nearObject != nil {
+		get.WithNearObject(fb.nearObject)
+	}
+	if fb.hybrid != nil {
+		get.WithHybrid(fb.hybrid)
+	}
+	if fb.bm25 != nil {
+		get.WithBM25(fb.bm25)
+	}
+	if fb.includesLimit {
+		get.WithLimit(fb.limit)
+	}
+	return get
+}
+
+// facetQuery returns the Aggregate query counting the values of property
+func (fb *FacetedSearchBuilder) facetQuery(property string) *AggregateBuilder {
+	aggregate := (&AggregateBuilder{connection: fb.connection, strictErrors: true}).
+		WithClassName(fb.className).WithGroupBy(property).
+		WithAggregations(MetaAggregation{}, GroupedByAggregation{})
+	if fb.tenant != "" {
+		aggregate.WithTenant(fb.tenant)
+	}
+	if fb.where != nil {
+		aggregate.WithWhere(fb.where)
+	}
+	if fb.nearText != nil {
+		aggregate.WithNearText(fb.nearText)
+	}
+	if fb.nearVector != nil {
+		aggregate.WithNearVector(fb.nearVector)
+	}
+	if fb.nearObject != nil {
+		aggregate.WithNearObject(fb.nearObject)
+	}
+	if fb.hybrid != nil {
+		aggregate.WithHybrid(fb.hybrid)
+	}
+	if fb.bm25 != nil {
+		aggregate.WithBM25(fb.bm25)
+	}
+	if fb.includesLimit {
+		aggregate.WithObjectLimit(fb.limit)
+	}
+	if fb.facetLimit > 0 {
+		aggregate.WithLimit(fb.facetLimit)
+	}
+	return aggregate
+}
+
+// vectorSearches returns the vector searches which are set
+func (fb *FacetedSearchBuilder) vectorSearches() []vectorSearch {
+	searches := []vectorSearch{}
+	if fb.nearText != nil {
+		searches = append(searches, fb.nearText)
+	}
+	if fb.nearVector != nil {
+		searches = append(searches, fb.nearVector)
+	}
+	if fb.nearObject != nil {
+		searches = append(searches, fb.nearObject)
+	}
+	if fb.hybrid != nil {
+		searches = append(searches, fb.hybrid)
+	}
+	return searches
+}
+
+// hasSearch reports whether the objects are ranked by a search, i.e. not only filtered
+func (fb *FacetedSearchBuilder) hasSearch() bool {
+	return fb.bm25 != nil || len(fb.vectorSearches()) > 0
+}
+
+// validate that the objects the facets are counted on are limited like the searched objects
+func (fb *FacetedSearchBuilder) validate() error {
+	if fb.includesLimit {
+		if !fb.hasSearch() {
+			return fmt.Errorf("faceted search: limit requires a search, the facets of a filter count all matching objects")
+		}
+		return nil
+	}
+	for _, search := range fb.vectorSearches() {
+		if !search.hasThreshold() {
+			return fmt.Errorf("faceted search: limit must be set for a vector search without certainty or distance")
+		}
+	}
+	return nil
+}
+
+// Do runs the search and counts the facets. In dry-run mode the queries are handed to the
+// hook and the result contains no facets.
+func (fb *FacetedSearchBuilder) Do(ctx context.Context) (*FacetedSearchResult, error) {
+	if err := fb.validate(); err != nil {
+		return nil, except.NewDerivedWeaviateClientError(err)
+	}
+	get := fb.getQuery()
+	if err := get.validate(); err != nil {
+		return nil, except.NewDerivedWeaviateClientError(err)
+	}
+	facets := make([]*AggregateBuilder, len(fb.facets))
+	for i, property := range fb.facets {
+		facets[i] = fb.facetQuery(property)
+		if err := facets[i].validate(); err != nil {
+			return nil, except.NewDerivedWeaviateClientError(fmt.Errorf("facet %q: %w", property, err))
+		}
+	}
+
+	var responses []*models.GraphQLResponse
+	var err error
+	if fb.batch {
+		responses, err = fb.runBatch(ctx, get, facets)
+	} else {
+		responses, err = fb.runConcurrently(ctx, get, facets)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FacetedSearchResult{
+		Response:  responses[0],
+		Facets:    make(map[string][]FacetBucket, len(fb.facets)),
+		className: fb.className,
+	}
+	if fb.dryRun {
+		return result, nil
+	}
+	for i, property := range fb.facets {
+		buckets, err := facetBuckets(responses[i+1], fb.className)
+		if err != nil {
+			return nil, except.NewDerivedWeaviateClientError(fmt.Errorf("facet %q: %w", property, err))
+		}
+		result.Facets[property] = buckets
+	}
+	return result, nil
+}
+
+// runConcurrently runs the Get query and the facet queries in parallel requests. The first
+// failing request cancels the others.
+func (fb *FacetedSearchBuilder) runConcurrently(ctx context.Context, get *GetBuilder, facets []*AggregateBuilder) ([]*models.GraphQLResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	responses := make([]*models.GraphQLResponse, len(facets)+1)
+	var mutex sync.Mutex
+	var firstErr error
+	run := func(i int, do func(context.Context) (*models.GraphQLResponse, error)) {
+		response, err := do(ctx)
+		if err != nil {
+			mutex.Lock()
+			if firstErr == nil {
+				firstErr = err
+				cancel()
+			}
+			mutex.Unlock()
+			return
+		}
+		responses[i] = response
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(facets) + 1)
+	go func() {
+		defer wg.Done()
+		run(0, get.Do)
+	}()
+	for i := range facets {
+		go func(i int) {
+			defer wg.Done()
+			run(i+1, facets[i].Do)
+		}(i)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return responses, nil
+}
+
+// runBatch sends the Get query and the facet queries in a single batch request
+func (fb *FacetedSearchBuilder) runBatch(ctx context.Context, get *GetBuilder, facets []*AggregateBuilder) ([]*models.GraphQLResponse, error) {
+	queries := make([]Query, 0, len(facets)+1)
+	queries = append(queries, get)
+	for _, facet := range facets {
+		queries = append(queries, facet)
+	}
+	results, err := (&BatchBuilder{connection: fb.connection}).WithQueries(queries...).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]*models.GraphQLResponse, len(results))
+	for i, result := range results {
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		responses[i] = result.Response
+	}
+	return responses, nil
+}
+
+func facetBuckets(response *models.GraphQLResponse, className string) ([]FacetBucket, error) {
+	groups, err := DecodeAggregate(response, className)
+	if err != nil {
+		return nil, err
+	}
+	buckets := make([]FacetBucket, 0, len(groups))
+	for _, group := range groups {
+		if group.GroupedBy == nil || group.Meta == nil || group.Meta.Count == nil {
+			return nil, fmt.Errorf("group without groupedBy value or count")
+		}
+		buckets = append(buckets, FacetBucket{Value: group.GroupedBy.Value, Count: *group.Meta.Count})
+	}
+	return buckets, nil
+}
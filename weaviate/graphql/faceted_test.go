@@ -0,0 +1,202 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// facetServer answers Get and grouped Aggregate queries of Pizza and records the queries
+type facetServer struct {
+	sync.Mutex
+	queries []string
+	paths   []string
+}
+
+func (s *facetServer) respond(query string) string {
+	switch {
+	case strings.Contains(query, `groupBy: "origin"`):
+		return `{"data":{"Aggregate":{"Pizza":[` +
+			`{"meta":{"count":2},"groupedBy":{"value":"Italy","path":["origin"]}},` +
+			`{"meta":{"count":1},"groupedBy":{"value":"USA","path":["origin"]}}]}}}`
+	case strings.Contains(query, `groupBy: "vegetarian"`):
+		return `{"data":{"Aggregate":{"Pizza":[{"meta":{"count":3},"groupedBy":{"value":"true","path":["vegetarian"]}}]}}}`
+	case strings.Contains(query, `groupBy: "broken"`):
+		return `{"data":{"Aggregate":{"Pizza":null}},"errors":[{"message":"no such prop"}]}`
+	default:
+		return `{"data":{"Get":{"Pizza":[{"name":"Margherita"},{"name":"Hawaii"}]}}}`
+	}
+}
+
+func (s *facetServer) RunREST(ctx context.Context, path string, restMethod string, requestBody interface{}) (*connection.ResponseData, error) {
+	s.Lock()
+	defer s.Unlock()
+	s.paths = append(s.paths, path)
+	if queries, ok := requestBody.(models.GraphQLQueries); ok {
+		responses := make([]string, len(queries))
+		for i, query := range queries {
+			s.queries = append(s.queries, query.Query)
+			responses[i] = s.respond(query.Query)
+		}
+		return &connection.ResponseData{StatusCode: 200, Body: []byte("[" + strings.Join(responses, ",") + "]")}, nil
+	}
+	query := requestBody.(*models.GraphQLQuery).Query
+	s.queries = append(s.queries, query)
+	return &connection.ResponseData{StatusCode: 200, Body: []byte(s.respond(query))}, nil
+}
+
+func TestFacetedSearch(t *testing.T) {
+	newSearch := func(server *facetServer) *FacetedSearchBuilder {
+		return (&API{connection: server}).FacetedSearch().
+			WithClassName("Pizza").
+			WithFields(Field{Name: "name"}).
+			WithWhere(filters.Where().WithPath([]string{"price"}).WithOperator(filters.LessThan).WithValueNumber(10)).
+			WithBM25((&BM25ArgumentBuilder{}).WithQuery("cheese")).
+			WithLimit(2).
+			WithFacets("origin", "vegetarian").
+			WithFacetLimit(5)
+	}
+	expectedFacets := map[string][]FacetBucket{
+		"origin":     {{Value: "Italy", Count: 2}, {Value: "USA", Count: 1}},
+		"vegetarian": {{Value: "true", Count: 3}},
+	}
+	type pizza struct {
+		Name string `json:"name"`
+	}
+
+	for _, batch := range []bool{false, true} {
+		name := "concurrent"
+		if batch {
+			name = "batch"
+		}
+		t.Run(name, func(t *testing.T) {
+			server := &facetServer{}
+			result, err := newSearch(server).WithBatch(batch).Do(context.Background())
+			require.NoError(t, err)
+
+			assert.Equal(t, expectedFacets, result.Facets)
+			var pizzas []pizza
+			require.NoError(t, result.DecodeObjects(&pizzas))
+			assert.Equal(t, []pizza{{Name: "Margherita"}, {Name: "Hawaii"}}, pizzas)
+
+			require.Len(t, server.queries, 3)
+			for _, query := range server.queries {
+				assert.Contains(t, query, `where:{operator: LessThan path: ["price"] valueNumber: 10}`)
+				assert.Contains(t, query, `bm25:{query: "cheese"}`)
+			}
+			if batch {
+				assert.Equal(t, []string{"/graphql/batch"}, server.paths)
+			} else {
+				assert.Len(t, server.paths, 3)
+			}
+		})
+	}
+
+	t.Run("queries share the search", func(t *testing.T) {
+		search := newSearch(&facetServer{})
+
+		assert.Equal(t, `{Get {Pizza (where:{operator: LessThan path: ["price"] valueNumber: 10}, bm25:{query: "cheese"}, limit: 2) {name}}}`,
+			search.getQuery().build())
+		assert.Equal(t, `{Aggregate{Pizza(groupBy: "origin", where:{operator: LessThan path: ["price"] valueNumber: 10}, `+
+			`bm25:{query: "cheese"}, objectLimit: 2, limit: 5){meta{count} groupedBy{value path}}}}`,
+			search.facetQuery("origin").build())
+	})
+
+	t.Run("facets of a filter count all matching objects", func(t *testing.T) {
+		server := &facetServer{}
+		search := newSearch(server).WithBM25(nil)
+		_, err := search.Do(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "limit requires a search")
+		assert.Empty(t, server.queries, "no request is sent")
+
+		search = (&API{connection: server}).FacetedSearch().WithClassName("Pizza").WithFields(Field{Name: "name"}).
+			WithWhere(filters.Where().WithPath([]string{"price"}).WithOperator(filters.LessThan).WithValueNumber(10)).
+			WithFacets("origin")
+		assert.Equal(t, `{Aggregate{Pizza(groupBy: "origin", where:{operator: LessThan path: ["price"] valueNumber: 10})`+
+			`{meta{count} groupedBy{value path}}}}`,
+			search.facetQuery("origin").build())
+		_, err = search.Do(context.Background())
+		assert.NoError(t, err)
+	})
+
+	t.Run("errors of a facet", func(t *testing.T) {
+		for _, batch := range []bool{false, true} {
+			_, err := newSearch(&facetServer{}).WithFacets("broken").WithBatch(batch).Do(context.Background())
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("vector search without limit", func(t *testing.T) {
+		server := &facetServer{}
+		search := (&API{connection: server}).FacetedSearch().WithClassName("Pizza").WithFields(Field{Name: "name"}).
+			WithFacets("origin")
+		_, err := search.WithNearVector((&NearVectorArgumentBuilder{}).WithVector([]float32{0.1})).
+			Do(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "limit must be set")
+		assert.Empty(t, server.queries, "no request is sent")
+
+		_, err = search.WithNearVector((&NearVectorArgumentBuilder{}).WithVector([]float32{0.1}).WithDistance(0.2)).
+			Do(context.Background())
+		assert.NoError(t, err)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		var queries []*models.GraphQLQuery
+		api := (&API{}).WithDryRun(func(query *models.GraphQLQuery) {
+			queries = append(queries, query)
+		})
+		search := api.FacetedSearch().WithClassName("Pizza").WithFields(Field{Name: "name"}).
+			WithBM25((&BM25ArgumentBuilder{}).WithQuery("cheese")).WithLimit(2).WithFacets("origin")
+		for _, batch := range []bool{false, true} {
+			queries = nil
+			result, err := search.WithBatch(batch).Do(context.Background())
+			require.NoError(t, err)
+			assert.Empty(t, result.Facets)
+			assert.Len(t, queries, 2)
+		}
+	})
+
+	t.Run("a failing request cancels the others", func(t *testing.T) {
+		server := &cancelServer{failing: `groupBy: "origin"`}
+		_, err := (&API{connection: server}).FacetedSearch().WithClassName("Pizza").WithFields(Field{Name: "name"}).
+			WithFacets("origin", "vegetarian").Do(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "facet failed")
+		assert.Equal(t, int32(2), atomic.LoadInt32(&server.cancelled))
+	})
+}
+
+// cancelServer fails the query containing failing and blocks all others until they are cancelled
+type cancelServer struct {
+	failing   string
+	cancelled int32
+}
+
+func (s *cancelServer) RunREST(ctx context.Context, path string, restMethod string, requestBody interface{}) (*connection.ResponseData, error) {
+	if strings.Contains(requestBody.(*models.GraphQLQuery).Query, s.failing) {
+		return nil, errors.New("facet failed")
+	}
+	<-ctx.Done()
+	atomic.AddInt32(&s.cancelled, 1)
+	return nil, ctx.Err()
+}
+
+func TestFacetBuckets(t *testing.T) {
+	var response models.GraphQLResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"data":{"Aggregate":{"Pizza":[{"meta":{"count":1}}]}}}`), &response))
+
+	_, err := facetBuckets(&response, "Pizza")
+	assert.Error(t, err, "groupedBy is required")
+}
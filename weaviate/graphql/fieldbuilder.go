@@ -3,13 +3,35 @@ package graphql
 import (
 	"fmt"
 	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 )
 
+// Field of a query. The Name is embedded into the query as raw GraphQL, so that it can also
+// hold nested selections like "meta{count}" or inline fragments like "... on Class". It is
+// validated so that it can't alter the rest of the query.
 type Field struct {
 	Name   string
 	Fields []Field
 }
 
+// validate the field and its nested fields
+func (f Field) validate() error {
+	if err := gql.ValidateSelection(f.Name); err != nil {
+		return err
+	}
+	return validateFields(f.Fields)
+}
+
+func validateFields(fields []Field) error {
+	for i := range fields {
+		if err := fields[i].validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (f Field) build() string {
 	clause := []string{}
 	if len(f.Name) > 0 {
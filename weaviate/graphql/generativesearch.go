@@ -1,15 +1,20 @@
 package graphql
 
 import (
-	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 )
 
 type GenerativeSearchBuilder struct {
 	prompt     string
 	task       string
 	properties []string
+	provider   *GenerativeProvider
+	debug      bool
 }
 
 func NewGenerativeSearch() *GenerativeSearchBuilder {
@@ -27,23 +32,75 @@ func (gsb *GenerativeSearchBuilder) GroupedResult(task string, properties ...str
 	return gsb
 }
 
+// WithProvider generates the results with the given provider instead of the generative
+// module configured for the class
+func (gsb *GenerativeSearchBuilder) WithProvider(provider *GenerativeProvider) *GenerativeSearchBuilder {
+	gsb.provider = provider
+	return gsb
+}
+
+// WithDebug requests the prompts sent to the provider, returned as debug{prompt}
+func (gsb *GenerativeSearchBuilder) WithDebug(debug bool) *GenerativeSearchBuilder {
+	gsb.debug = debug
+	return gsb
+}
+
+// placeholderRe matches the placeholders of properties in a prompt, e.g. {name}
+var placeholderRe = regexp.MustCompile(`\{([_A-Za-z][_0-9A-Za-z]*)\}`)
+
+// Placeholders returns the names of the properties referenced in the prompt of the single result
+func (gsb *GenerativeSearchBuilder) Placeholders() []string {
+	var names []string
+	for _, match := range placeholderRe.FindAllStringSubmatch(gsb.prompt, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// validate that the properties referenced by placeholders are part of the requested fields,
+// otherwise weaviate has no values to fill them with, and that the provider can be formatted
+func (gsb *GenerativeSearchBuilder) validate(fields []Field) error {
+	for _, name := range gsb.Placeholders() {
+		if !containsField(fields, name) {
+			return fmt.Errorf("generative search: property %q of prompt placeholder {%s} is not requested", name, name)
+		}
+	}
+	if gsb.provider != nil {
+		return gsb.provider.validate()
+	}
+	return nil
+}
+
 func (gsb *GenerativeSearchBuilder) build() Field {
+	return gsb.buildWith(nil)
+}
+
+func (gsb *GenerativeSearchBuilder) buildWith(vars *gql.Variables) Field {
 	nameParts := []string{}
 	fieldNames := []string{}
 
+	options := []string{}
+	if gsb.debug {
+		options = append(options, "debug:true")
+	}
+	if gsb.provider != nil {
+		options = append(options, gsb.provider.build())
+	}
+
 	if gsb.prompt != "" {
-		nameParts = append(nameParts, fmt.Sprintf("singleResult:{prompt:\"\"\"%s\"\"\"}", gsb.prompt))
+		argParts := append([]string{fmt.Sprintf("prompt:%s", vars.Value("String", gsb.prompt))}, options...)
+		nameParts = append(nameParts, fmt.Sprintf("singleResult:{%s}", strings.Join(argParts, ",")))
 		fieldNames = append(fieldNames, "singleResult")
 	}
 	if gsb.task != "" || len(gsb.properties) > 0 {
 		argParts := []string{}
 		if gsb.task != "" {
-			argParts = append(argParts, fmt.Sprintf("task:\"\"\"%s\"\"\"", gsb.task))
+			argParts = append(argParts, fmt.Sprintf("task:%s", vars.Value("String", gsb.task)))
 		}
 		if len(gsb.properties) > 0 {
-			properties, _ := json.Marshal(gsb.properties)
-			argParts = append(argParts, fmt.Sprintf("properties:%v", string(properties)))
+			argParts = append(argParts, fmt.Sprintf("properties:%s", gql.QuoteList(gsb.properties)))
 		}
+		argParts = append(argParts, options...)
 		nameParts = append(nameParts, fmt.Sprintf("groupedResult:{%s}", strings.Join(argParts, ",")))
 		fieldNames = append(fieldNames, "groupedResult")
 	}
@@ -58,9 +115,75 @@ func (gsb *GenerativeSearchBuilder) build() Field {
 	for i, fieldName := range fieldNames {
 		fields[i] = Field{Name: fieldName}
 	}
+	if gsb.debug {
+		fields = append(fields, Field{Name: "debug", Fields: []Field{{Name: "prompt"}}})
+	}
 
 	return Field{
 		Name:   fmt.Sprintf("generate(%s)", strings.Join(nameParts, " ")),
 		Fields: fields,
 	}
 }
+
+// GenerativeProvider selects the generative module used for a single request and its options,
+// e.g. the model. It requires a weaviate version which supports per request providers.
+type GenerativeProvider struct {
+	name    string
+	options map[string]interface{}
+}
+
+// NewGenerativeProvider for the provider with the given name as used by weaviate, e.g.
+// openai, anthropic or cohere
+func NewGenerativeProvider(name string) *GenerativeProvider {
+	return &GenerativeProvider{name: name, options: map[string]interface{}{}}
+}
+
+// WithModel used to generate the results
+func (p *GenerativeProvider) WithModel(model string) *GenerativeProvider {
+	return p.WithOption("model", model)
+}
+
+// WithTemperature of the model
+func (p *GenerativeProvider) WithTemperature(temperature float32) *GenerativeProvider {
+	return p.WithOption("temperature", temperature)
+}
+
+// WithMaxTokens the model generates
+func (p *GenerativeProvider) WithMaxTokens(maxTokens int) *GenerativeProvider {
+	return p.WithOption("maxTokens", maxTokens)
+}
+
+// WithOption sets any other option the provider supports, e.g. topP or baseURL. The value may be
+// a string, number, boolean or a slice or map of these, maps are sent as GraphQL input objects.
+func (p *GenerativeProvider) WithOption(name string, value interface{}) *GenerativeProvider {
+	p.options[name] = value
+	return p
+}
+
+func (p *GenerativeProvider) validate() error {
+	if !gql.IsName(p.name) {
+		return fmt.Errorf("generative search: invalid provider name %q", p.name)
+	}
+	for name, value := range p.options {
+		if !gql.IsName(name) {
+			return fmt.Errorf("generative search: invalid option name %q of provider %s", name, p.name)
+		}
+		if err := gql.ValidateLiteral(value); err != nil {
+			return fmt.Errorf("generative search: invalid value of option %s of provider %s: %w", name, p.name, err)
+		}
+	}
+	return nil
+}
+
+func (p *GenerativeProvider) build() string {
+	names := make([]string, 0, len(p.options))
+	for name := range p.options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	options := make([]string, len(names))
+	for i, name := range names {
+		options[i] = fmt.Sprintf("%s:%s", name, gql.Literal(p.options[name]))
+	}
+	return fmt.Sprintf("%s:{%s}", p.name, strings.Join(options, ","))
+}
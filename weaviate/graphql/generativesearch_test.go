@@ -1,9 +1,12 @@
 package graphql
 
 import (
+	"context"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGenerativeSearch_build(t *testing.T) {
@@ -11,7 +14,7 @@ func TestGenerativeSearch_build(t *testing.T) {
 		gs := NewGenerativeSearch().SingleResult("Describe this pizza : {name}")
 		result := gs.build()
 
-		assert.Equal(t, `generate(singleResult:{prompt:"""Describe this pizza : {name}"""})`, result.Name)
+		assert.Equal(t, `generate(singleResult:{prompt:"Describe this pizza : {name}"})`, result.Name)
 		assert.ElementsMatch(t, []Field{{Name: "singleResult"}, {Name: "error"}}, result.Fields)
 	})
 
@@ -19,7 +22,7 @@ func TestGenerativeSearch_build(t *testing.T) {
 		gs := NewGenerativeSearch().GroupedResult("Why are these pizzas very popular?")
 		result := gs.build()
 
-		assert.Equal(t, `generate(groupedResult:{task:"""Why are these pizzas very popular?"""})`, result.Name)
+		assert.Equal(t, `generate(groupedResult:{task:"Why are these pizzas very popular?"})`, result.Name)
 		assert.ElementsMatch(t, []Field{{Name: "groupedResult"}, {Name: "error"}}, result.Fields)
 	})
 
@@ -27,7 +30,7 @@ func TestGenerativeSearch_build(t *testing.T) {
 		gs := NewGenerativeSearch().SingleResult("Describe this pizza : {name}").GroupedResult("Why are these pizzas very popular?")
 		result := gs.build()
 
-		assert.Equal(t, `generate(singleResult:{prompt:"""Describe this pizza : {name}"""} groupedResult:{task:"""Why are these pizzas very popular?"""})`, result.Name)
+		assert.Equal(t, `generate(singleResult:{prompt:"Describe this pizza : {name}"} groupedResult:{task:"Why are these pizzas very popular?"})`, result.Name)
 		assert.ElementsMatch(t, []Field{{Name: "singleResult"}, {Name: "groupedResult"}, {Name: "error"}}, result.Fields)
 	})
 
@@ -35,7 +38,7 @@ func TestGenerativeSearch_build(t *testing.T) {
 		gs := NewGenerativeSearch().GroupedResult("Why are these pizzas very popular?", "property1", "property2")
 		result := gs.build()
 
-		assert.Equal(t, `generate(groupedResult:{task:"""Why are these pizzas very popular?""",properties:["property1","property2"]})`, result.Name)
+		assert.Equal(t, `generate(groupedResult:{task:"Why are these pizzas very popular?",properties:["property1","property2"]})`, result.Name)
 		assert.ElementsMatch(t, []Field{{Name: "groupedResult"}, {Name: "error"}}, result.Fields)
 	})
 
@@ -43,7 +46,83 @@ func TestGenerativeSearch_build(t *testing.T) {
 		gs := NewGenerativeSearch().SingleResult("Describe this pizza : {name}").GroupedResult("Why are these pizzas very popular?", "prop1")
 		result := gs.build()
 
-		assert.Equal(t, `generate(singleResult:{prompt:"""Describe this pizza : {name}"""} groupedResult:{task:"""Why are these pizzas very popular?""",properties:["prop1"]})`, result.Name)
+		assert.Equal(t, `generate(singleResult:{prompt:"Describe this pizza : {name}"} groupedResult:{task:"Why are these pizzas very popular?",properties:["prop1"]})`, result.Name)
 		assert.ElementsMatch(t, []Field{{Name: "singleResult"}, {Name: "groupedResult"}, {Name: "error"}}, result.Fields)
 	})
+
+	t.Run("with provider and debug", func(t *testing.T) {
+		provider := NewGenerativeProvider("openai").WithModel("gpt-4").WithTemperature(0.5).WithMaxTokens(100)
+		gs := NewGenerativeSearch().SingleResult("Describe {name}").GroupedResult("Summarize").
+			WithProvider(provider).WithDebug(true)
+		result := gs.build()
+
+		assert.Equal(t, `generate(singleResult:{prompt:"Describe {name}",debug:true,openai:{maxTokens:100,model:"gpt-4",temperature:0.5}} `+
+			`groupedResult:{task:"Summarize",debug:true,openai:{maxTokens:100,model:"gpt-4",temperature:0.5}})`, result.Name)
+		assert.Equal(t, []Field{
+			{Name: "singleResult"},
+			{Name: "groupedResult"},
+			{Name: "error"},
+			{Name: "debug", Fields: []Field{{Name: "prompt"}}},
+		}, result.Fields)
+	})
+}
+
+func TestGenerativeSearch_validate(t *testing.T) {
+	fields := []Field{{Name: "name"}, {Name: "description"}}
+
+	t.Run("placeholders", func(t *testing.T) {
+		gs := NewGenerativeSearch().SingleResult("Describe {name}: {description} {{name}}")
+		assert.Equal(t, []string{"name", "description", "name"}, gs.Placeholders())
+		assert.NoError(t, gs.validate(fields))
+	})
+
+	t.Run("placeholder of a property which is not requested", func(t *testing.T) {
+		gs := NewGenerativeSearch().SingleResult("Describe {name} with {price}")
+		err := gs.validate(fields)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"price"`)
+	})
+
+	t.Run("invalid provider", func(t *testing.T) {
+		gs := NewGenerativeSearch().GroupedResult("Summarize").WithProvider(NewGenerativeProvider("open ai"))
+		assert.Error(t, gs.validate(fields))
+
+		gs = NewGenerativeSearch().GroupedResult("Summarize").WithProvider(NewGenerativeProvider("openai").WithOption("top p", 1))
+		assert.Error(t, gs.validate(fields))
+	})
+
+	t.Run("invalid option values", func(t *testing.T) {
+		for _, provider := range []*GenerativeProvider{
+			NewGenerativeProvider("openai").WithTemperature(float32(math.NaN())),
+			NewGenerativeProvider("openai").WithOption("topP", math.Inf(1)),
+			NewGenerativeProvider("openai").WithOption("extra", map[string]interface{}{"a b": 1}),
+			NewGenerativeProvider("openai").WithOption("extra", make(chan int)),
+		} {
+			gs := NewGenerativeSearch().GroupedResult("Summarize").WithProvider(provider)
+			err := gs.validate(fields)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "invalid value of option")
+		}
+
+		builder := &GetBuilder{connection: &MockRunREST{}}
+		_, err := builder.WithClassName("Pizza").WithFields(Field{Name: "name"}).
+			WithGenerativeSearch(NewGenerativeSearch().GroupedResult("Summarize").
+				WithProvider(NewGenerativeProvider("openai").WithTemperature(float32(math.NaN())))).
+			Do(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("map options are formatted as objects", func(t *testing.T) {
+		provider := NewGenerativeProvider("openai").WithOption("extra", map[string]interface{}{"b": []int{1, 2}, "a": "x"})
+		require.NoError(t, provider.validate())
+		assert.Equal(t, `openai:{extra:{a:"x",b:[1,2]}}`, provider.build())
+	})
+
+	t.Run("validated by Get", func(t *testing.T) {
+		builder := &GetBuilder{connection: &MockRunREST{}}
+		_, err := builder.WithClassName("Pizza").WithFields(Field{Name: "name"}).
+			WithGenerativeSearch(NewGenerativeSearch().SingleResult("Describe {price}")).
+			Do(context.Background())
+		assert.Error(t, err)
+	})
 }
@@ -2,19 +2,28 @@ package graphql
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"reflect"
 	"strings"
 
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/fault"
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/filters"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
 // GetBuilder for GraphQL
 type GetBuilder struct {
-	connection rest
-	className  string
-	withFields []Field
+	connection     rest
+	strictErrors   bool
+	queryVariables bool
+	className      string
+	withFields     []Field
 
 	includesFilterClause bool // true if brackets behind class is needed
 	includesLimit        bool
@@ -43,6 +52,7 @@ type GetBuilder struct {
 	withBM25             *BM25ArgumentBuilder
 	withHybrid           *HybridArgumentBuilder
 	withGenerativeSearch *GenerativeSearchBuilder
+	withRerank           *RerankBuilder
 	withGroupBy          *GroupByArgumentBuilder
 }
 
@@ -203,6 +213,13 @@ func (gb *GetBuilder) WithGenerativeSearch(s *GenerativeSearchBuilder) *GetBuild
 	return gb
 }
 
+// WithRerank reranks the results with the reranker module of the class, the score is
+// returned in _additional{rerank{score}}
+func (gb *GetBuilder) WithRerank(r *RerankBuilder) *GetBuilder {
+	gb.withRerank = r
+	return gb
+}
+
 // WithGroupBy to perform group by operation
 func (gb *GetBuilder) WithGroupBy(groupBy *GroupByArgumentBuilder) *GetBuilder {
 	gb.includesFilterClause = true
@@ -223,31 +240,163 @@ func (gb *GetBuilder) WithTenant(tenant string) *GetBuilder {
 	return gb
 }
 
+// WithStrictErrors makes Do fail with a *fault.GraphQLError if the GraphQL response contains errors.
+// The partial data of the response is still returned next to the error.
+func (gb *GetBuilder) WithStrictErrors(strict bool) *GetBuilder {
+	gb.strictErrors = strict
+	return gb
+}
+
+// WithQueryVariables sends the search values, i.e. vectors, texts and single filter values,
+// as GraphQL variables instead of inlining them into the query string. The query string then
+// only depends on the structure of the query, which makes it cheaper to build and cacheable.
+func (gb *GetBuilder) WithQueryVariables(queryVariables bool) *GetBuilder {
+	gb.queryVariables = queryVariables
+	return gb
+}
+
 // Do execute the GraphQL query
 func (gb *GetBuilder) Do(ctx context.Context) (*models.GraphQLResponse, error) {
-	return runGraphQLQuery(ctx, gb.connection, gb.build())
+	if err := gb.validate(); err != nil {
+		return nil, except.NewDerivedWeaviateClientError(err)
+	}
+	return runGraphQLQuery(ctx, gb.connection, gb.buildQuery(), gb.strictErrors)
+}
+
+// DoStream executes the GraphQL query like Do, but decodes the returned objects one by one
+// and hands each of them to handle as raw JSON, so that memory stays bounded regardless of
+// the number of objects. An error returned by handle stops the decoding and is returned as is.
+// Errors reported by GraphQL are returned after all objects have been handled.
+func (gb *GetBuilder) DoStream(ctx context.Context, handle func(object json.RawMessage) error) error {
+	if err := gb.validate(); err != nil {
+		return except.NewDerivedWeaviateClientError(err)
+	}
+	var handleErr error
+	var gqlErrors []*models.GraphQLError
+	err := runGraphQLQueryStream(ctx, gb.connection, gb.buildQuery(), func(statusCode int, body io.Reader) error {
+		if statusCode != 200 {
+			responseBody, err := io.ReadAll(body)
+			if err != nil {
+				return err
+			}
+			return except.NewUnexpectedStatusCodeErrorFromRESTResponse(&connection.ResponseData{
+				StatusCode: statusCode,
+				Body:       responseBody,
+				Method:     http.MethodPost,
+				Path:       "/graphql",
+			})
+		}
+
+		stream := connection.NewJSONStream(body)
+		return stream.Object(func(key string) error {
+			switch key {
+			case "data":
+				return stream.Object(func(key string) error {
+					if key != "Get" {
+						return stream.Skip()
+					}
+					return stream.Object(func(className string) error {
+						return stream.Array(func() error {
+							var object json.RawMessage
+							if err := stream.Decode(&object); err != nil {
+								return err
+							}
+							handleErr = handle(object)
+							return handleErr
+						})
+					})
+				})
+			case "errors":
+				return stream.Decode(&gqlErrors)
+			default:
+				return stream.Skip()
+			}
+		})
+	})
+	if handleErr != nil {
+		return handleErr
+	}
+	if err != nil {
+		if clientErr, ok := err.(*fault.WeaviateClientError); ok {
+			return clientErr
+		}
+		return except.NewDerivedWeaviateClientError(err)
+	}
+	if len(gqlErrors) > 0 {
+		return &fault.GraphQLError{Errors: gqlErrors}
+	}
+	return nil
+}
+
+// validate the parts of the query which can't be escaped
+func (gb *GetBuilder) validate() error {
+	if err := gql.ValidateClassName(gb.className); err != nil {
+		return err
+	}
+	if err := validateFields(gb.withFields); err != nil {
+		return err
+	}
+	if gb.withGroupBy != nil {
+		if err := validateFields(gb.withGroupBy.hitFields); err != nil {
+			return err
+		}
+	}
+	if gb.withHybrid != nil {
+		if err := gb.withHybrid.validate(); err != nil {
+			return err
+		}
+	}
+	if gb.withRerank != nil {
+		if err := gb.withRerank.validate(); err != nil {
+			return err
+		}
+	}
+	if gb.withGenerativeSearch != nil {
+		return gb.withGenerativeSearch.validate(gb.withFields)
+	}
+	return nil
 }
 
 // build the GraphQL query string (not needed when Do is executed)
 func (gb *GetBuilder) build() string {
+	return gb.buildWith(nil)
+}
+
+// Build the GraphQL query as it is sent by Do, including the variables if enabled
+func (gb *GetBuilder) Build() *models.GraphQLQuery {
+	return gb.buildQuery()
+}
+
+// String returns the GraphQL query string as it is sent by Do
+func (gb *GetBuilder) String() string {
+	return gb.buildQuery().Query
+}
+
+// buildQuery with the values passed as variables if query variables are enabled
+func (gb *GetBuilder) buildQuery() *models.GraphQLQuery {
+	vars := newVariables(gb.queryVariables)
+	return graphQLQuery(gb.buildWith(vars), vars)
+}
+
+func (gb *GetBuilder) buildWith(vars *gql.Variables) string {
 	filterClause := ""
 	if gb.includesFilterClause {
-		filterClause = gb.createFilterClause()
+		filterClause = gb.createFilterClause(vars)
 	}
-	fieldsClause := gb.createFieldsClause()
+	fieldsClause := gb.createFieldsClause(vars)
 
 	query := fmt.Sprintf("{Get {%v %v {%v}}}", gb.className, filterClause, fieldsClause)
 
 	return query
 }
 
-func (gb *GetBuilder) createFilterClause() string {
+func (gb *GetBuilder) createFilterClause(vars *gql.Variables) string {
 	filters := []string{}
 	if gb.tenant != "" {
-		filters = append(filters, fmt.Sprintf("tenant: %q", gb.tenant))
+		filters = append(filters, fmt.Sprintf("tenant: %s", vars.Value("String", gb.tenant)))
 	}
 	if gb.withWhereFilter != nil {
-		filters = append(filters, gb.withWhereFilter.String())
+		filters = append(filters, whereClause(gb.withWhereFilter, vars, "GetObjects"+gb.className))
 	}
 	for _, b := range []argumentBuilder{
 		gb.withBM25, gb.withHybrid, gb.withAskFilter, gb.withNearTextFilter, gb.withNearObjectFilter,
@@ -256,11 +405,11 @@ func (gb *GetBuilder) createFilterClause() string {
 	} {
 		bVal := reflect.ValueOf(b)
 		if bVal.Kind() == reflect.Ptr && !bVal.IsNil() {
-			filters = append(filters, b.build())
+			filters = append(filters, buildArgument(b, vars))
 		}
 	}
 	if gb.consistencyLevel != "" {
-		filters = append(filters, fmt.Sprintf("consistencyLevel: %s", gb.consistencyLevel))
+		filters = append(filters, fmt.Sprintf("consistencyLevel: %s", gql.Enum(gb.consistencyLevel)))
 	}
 	if gb.includesLimit {
 		filters = append(filters, fmt.Sprintf("limit: %v", gb.limit))
@@ -272,29 +421,38 @@ func (gb *GetBuilder) createFilterClause() string {
 		filters = append(filters, fmt.Sprintf("offset: %v", gb.offset))
 	}
 	if gb.includesAfter {
-		filters = append(filters, fmt.Sprintf("after: %q", gb.after))
+		filters = append(filters, fmt.Sprintf("after: %s", vars.Value("String", gb.after)))
 	}
 	return fmt.Sprintf("(%s)", strings.Join(filters, ", "))
 }
 
-func (gb *GetBuilder) createFieldsClause() string {
-	if len(gb.withFields) == 0 && gb.withGenerativeSearch == nil {
-		return ""
+func (gb *GetBuilder) createFieldsClause(vars *gql.Variables) string {
+	additionalFields := []Field{}
+	if gb.withGenerativeSearch != nil {
+		additionalFields = append(additionalFields, gb.withGenerativeSearch.buildWith(vars))
+	}
+	if gb.withRerank != nil {
+		additionalFields = append(additionalFields, gb.withRerank.buildWith(vars))
+	}
+	if gb.withHybrid != nil {
+		additionalFields = append(additionalFields, gb.withHybrid.additionalFields()...)
+	}
+	if gb.withGroupBy != nil {
+		additionalFields = append(additionalFields, gb.withGroupBy.additionalFields()...)
 	}
 
-	if gb.withGenerativeSearch == nil {
+	if len(additionalFields) == 0 {
 		return joinFields(gb.withFields)
 	}
 
-	generate := gb.withGenerativeSearch.build()
-	generateAdditional := Field{Name: "_additional", Fields: []Field{generate}}
+	additional := Field{Name: "_additional", Fields: additionalFields}
 
 	if len(gb.withFields) == 0 {
-		return generateAdditional.build()
+		return additional.build()
 	}
 
-	// check if _additional field exists. If missing just add new _additional with generate,
-	// if exists merge generate into present one
+	// check if _additional field exists. If missing just add new _additional with the additional
+	// fields, if exists merge them into present one
 	posAdditional := -1
 	for i := range gb.withFields {
 		if gb.withFields[i].Name == "_additional" {
@@ -304,18 +462,34 @@ func (gb *GetBuilder) createFieldsClause() string {
 	}
 
 	if posAdditional == -1 {
-		return joinFields(append(gb.withFields, generateAdditional))
+		return joinFields(append(append([]Field{}, gb.withFields...), additional))
 	}
 
 	mergedAdditional := Field{
 		Name:   "_additional",
-		Fields: append(gb.withFields[posAdditional].Fields, generate),
+		Fields: append([]Field{}, gb.withFields[posAdditional].Fields...),
+	}
+	for _, field := range additionalFields {
+		if !containsField(mergedAdditional.Fields, field.Name) {
+			mergedAdditional.Fields = append(mergedAdditional.Fields, field)
+		}
 	}
 
-	fields := append(gb.withFields[:posAdditional], gb.withFields[posAdditional+1:]...)
+	// copy the fields, so that building the query again doesn't see the merged fields
+	fields := append([]Field{}, gb.withFields[:posAdditional]...)
+	fields = append(fields, gb.withFields[posAdditional+1:]...)
 	return joinFields(append(fields, mergedAdditional))
 }
 
+func containsField(fields []Field, name string) bool {
+	for i := range fields {
+		if fields[i].Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func joinFields(fields []Field) string {
 	strFields := []string{}
 	for i := range fields {
@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"os"
@@ -111,7 +112,7 @@ func TestQueryBuilder(t *testing.T) {
 			WithGenerativeSearch(gs).
 			build()
 
-		expected := `{Get {Pizza  {name _additional{generate(singleResult:{prompt:"""Describe this pizza : {name}"""}){singleResult error}}}}}`
+		expected := `{Get {Pizza  {name _additional{generate(singleResult:{prompt:"Describe this pizza : {name}"}){singleResult error}}}}}`
 		assert.Equal(t, expected, query)
 	})
 
@@ -131,10 +132,47 @@ func TestQueryBuilder(t *testing.T) {
 			WithGenerativeSearch(gs).
 			build()
 
-		expected := `{Get {Pizza  {name _additional{id generate(singleResult:{prompt:"""Describe this pizza : {name}"""}){singleResult error}}}}}`
+		expected := `{Get {Pizza  {name _additional{id generate(singleResult:{prompt:"Describe this pizza : {name}"}){singleResult error}}}}}`
 		assert.Equal(t, expected, query)
 	})
 
+	t.Run("with rerank", func(t *testing.T) {
+		builder := GetBuilder{connection: &MockRunREST{}}
+
+		query := builder.WithClassName("Pizza").
+			WithFields(Field{Name: "name"}).
+			WithRerank(NewRerank().WithProperty("description").WithQuery("pineapple")).
+			build()
+
+		expected := `{Get {Pizza  {name _additional{rerank(property:"description" query:"pineapple"){score}}}}}`
+		assert.Equal(t, expected, query)
+	})
+
+	t.Run("with rerank, generative search and additional id", func(t *testing.T) {
+		builder := GetBuilder{connection: &MockRunREST{}}
+
+		query := builder.WithClassName("Pizza").
+			WithFields(Field{Name: "name"}, Field{Name: "_additional", Fields: []Field{{Name: "id"}}}).
+			WithGenerativeSearch(NewGenerativeSearch().SingleResult("Describe {name}")).
+			WithRerank(NewRerank().WithProperty("description")).
+			build()
+
+		expected := `{Get {Pizza  {name _additional{id generate(singleResult:{prompt:"Describe {name}"}){singleResult error} rerank(property:"description"){score}}}}}`
+		assert.Equal(t, expected, query)
+	})
+
+	t.Run("with hybrid explain score", func(t *testing.T) {
+		builder := GetBuilder{connection: &MockRunREST{}}
+		hybrid := (&HybridArgumentBuilder{}).WithQuery("pizza").WithExplainScore(true)
+		additional := Field{Name: "_additional", Fields: []Field{{Name: "id"}, {Name: "score"}}}
+
+		query := builder.WithClassName("Pizza").WithFields(Field{Name: "name"}, additional).WithHybrid(hybrid).build()
+
+		expected := `{Get {Pizza (hybrid:{query: "pizza"}) {name _additional{id score explainScore}}}}`
+		assert.Equal(t, expected, query)
+		assert.Equal(t, query, builder.build(), "building again must not change the query")
+	})
+
 	t.Run("with generative search grouped result", func(t *testing.T) {
 		conMock := &MockRunREST{}
 
@@ -150,7 +188,7 @@ func TestQueryBuilder(t *testing.T) {
 			WithGenerativeSearch(gs).
 			build()
 
-		expected := `{Get {Pizza  {name _additional{generate(groupedResult:{task:"""Why are these pizzas very popular?"""}){groupedResult error}}}}}`
+		expected := `{Get {Pizza  {name _additional{generate(groupedResult:{task:"Why are these pizzas very popular?"}){groupedResult error}}}}}`
 		assert.Equal(t, expected, query)
 	})
 
@@ -169,7 +207,7 @@ func TestQueryBuilder(t *testing.T) {
 			WithGenerativeSearch(gs).
 			build()
 
-		expected := `{Get {Pizza  {name _additional{generate(groupedResult:{task:"""Why are these pizzas very popular?""",properties:["title","description"]}){groupedResult error}}}}}`
+		expected := `{Get {Pizza  {name _additional{generate(groupedResult:{task:"Why are these pizzas very popular?",properties:["title","description"]}){groupedResult error}}}}}`
 		assert.Equal(t, expected, query)
 	})
 
@@ -189,7 +227,7 @@ func TestQueryBuilder(t *testing.T) {
 			WithGenerativeSearch(gs).
 			build()
 
-		expected := `{Get {Pizza  {name _additional{id generate(groupedResult:{task:"""Why are these pizzas very popular?"""}){groupedResult error}}}}}`
+		expected := `{Get {Pizza  {name _additional{id generate(groupedResult:{task:"Why are these pizzas very popular?"}){groupedResult error}}}}}`
 		assert.Equal(t, expected, query)
 	})
 
@@ -210,7 +248,7 @@ func TestQueryBuilder(t *testing.T) {
 			WithGenerativeSearch(gs).
 			build()
 
-		expected := `{Get {Pizza  {name _additional{generate(singleResult:{prompt:"""Describe this pizza : {name}"""} groupedResult:{task:"""Why are these pizzas very popular?"""}){singleResult groupedResult error}}}}}`
+		expected := `{Get {Pizza  {name _additional{generate(singleResult:{prompt:"Describe this pizza : {name}"} groupedResult:{task:"Why are these pizzas very popular?"}){singleResult groupedResult error}}}}}`
 		assert.Equal(t, expected, query)
 	})
 
@@ -232,7 +270,7 @@ func TestQueryBuilder(t *testing.T) {
 			WithGenerativeSearch(gs).
 			build()
 
-		expected := `{Get {Pizza  {name _additional{id generate(singleResult:{prompt:"""Describe this pizza : {name}"""} groupedResult:{task:"""Why are these pizzas very popular?"""}){singleResult groupedResult error}}}}}`
+		expected := `{Get {Pizza  {name _additional{id generate(singleResult:{prompt:"Describe this pizza : {name}"} groupedResult:{task:"Why are these pizzas very popular?"}){singleResult groupedResult error}}}}}`
 		assert.Equal(t, expected, query)
 	})
 
@@ -408,6 +446,17 @@ func TestQueryBuilder(t *testing.T) {
 		assert.Equal(t, expected, query)
 	})
 
+	t.Run("NearVector filter with target vectors", func(t *testing.T) {
+		builder := GetBuilder{connection: &MockRunREST{}}
+
+		nearVector := (&NearVectorArgumentBuilder{}).WithVector([]float32{0, 1}).WithTargetVectors("title")
+
+		query := builder.WithClassName("Pizza").WithFields(Field{Name: "name"}).WithNearVector(nearVector).build()
+
+		expected := `{Get {Pizza (nearVector:{vector: [0,1] targetVectors: ["title"]}) {name}}}`
+		assert.Equal(t, expected, query)
+	})
+
 	t.Run("Group filter", func(t *testing.T) {
 		conMock := &MockRunREST{}
 
@@ -1146,3 +1195,47 @@ func TestGet_NearMedia(t *testing.T) {
 		assert.Equal(t, expected, query)
 	})
 }
+
+func TestGet_DoStream(t *testing.T) {
+	t.Run("objects are handed out one by one", func(t *testing.T) {
+		conMock := &MockRunREST{
+			ReturnResponseData: &connection.ResponseData{
+				StatusCode: 200,
+				Body:       []byte(`{"data":{"Get":{"Pizza":[{"name":"Hawaii"},{"name":"Doener"}]}}}`),
+			},
+		}
+		builder := GetBuilder{connection: conMock}
+
+		var names []string
+		err := builder.WithClassName("Pizza").WithFields(Field{Name: "name"}).
+			DoStream(context.Background(), func(object json.RawMessage) error {
+				var pizza struct {
+					Name string `json:"name"`
+				}
+				require.NoError(t, json.Unmarshal(object, &pizza))
+				names = append(names, pizza.Name)
+				return nil
+			})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Hawaii", "Doener"}, names)
+		assert.Equal(t, "/graphql", conMock.ArgPath)
+	})
+
+	t.Run("graphql errors", func(t *testing.T) {
+		conMock := &MockRunREST{
+			ReturnResponseData: &connection.ResponseData{
+				StatusCode: 200,
+				Body:       []byte(`{"data":{"Get":{"Pizza":null}},"errors":[{"message":"no such prop"}]}`),
+			},
+		}
+		builder := GetBuilder{connection: conMock}
+
+		err := builder.WithClassName("Pizza").WithFields(Field{Name: "unknown"}).
+			DoStream(context.Background(), func(object json.RawMessage) error {
+				t.Fatal("no object expected")
+				return nil
+			})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no such prop")
+	})
+}
@@ -1,17 +1,24 @@
 package graphql
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"net/http"
+	"strings"
+	"sync"
 
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/fault"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
 // API group for GraphQL
 type API struct {
-	connection *connection.Connection
+	connection   rest
+	strictErrors bool
+	dryRun       bool
 }
 
 // New GraphQL api group from connection
@@ -19,9 +26,24 @@ func New(con *connection.Connection) *API {
 	return &API{connection: con}
 }
 
+// WithStrictErrors returns a GraphQL api group whose queries fail with a *fault.GraphQLError if
+// weaviate answers with errors in the GraphQL response. The partial data of the response is still
+// returned next to the error. By default such errors are only reported in GraphQLResponse.Errors.
+func (api *API) WithStrictErrors(strict bool) *API {
+	return &API{connection: api.connection, strictErrors: strict, dryRun: api.dryRun}
+}
+
+// WithDryRun returns a GraphQL api group whose queries are not sent to weaviate. Instead hook is
+// called with the query, including its variables, and Do returns an empty response. This allows
+// to log or inspect the exact queries without a weaviate instance. The hook is not called
+// concurrently, even if queries run in parallel.
+func (api *API) WithDryRun(hook func(query *models.GraphQLQuery)) *API {
+	return &API{connection: &dryRun{hook: hook}, strictErrors: api.strictErrors, dryRun: true}
+}
+
 // Get queries
 func (api *API) Get() *GetBuilder {
-	return &GetBuilder{connection: api.connection}
+	return &GetBuilder{connection: api.connection, strictErrors: api.strictErrors}
 }
 
 // Get queries with Multiple Class
@@ -29,22 +51,33 @@ func (api *API) MultiClassGet() *MultiClassBuilder {
 	return &MultiClassBuilder{
 		connection:    api.connection,
 		classBuilders: make(map[string]*GetBuilder),
+		strictErrors:  api.strictErrors,
 	}
 }
 
 // Explore queries
 func (api *API) Explore() *Explore {
-	return &Explore{connection: api.connection}
+	return &Explore{connection: api.connection, strictErrors: api.strictErrors}
 }
 
 // Aggregate queries
 func (api *API) Aggregate() *AggregateBuilder {
-	return &AggregateBuilder{connection: api.connection}
+	return &AggregateBuilder{connection: api.connection, strictErrors: api.strictErrors}
+}
+
+// FacetedSearch runs a Get query with facet counts of the searched objects
+func (api *API) FacetedSearch() *FacetedSearchBuilder {
+	return &FacetedSearchBuilder{connection: api.connection, dryRun: api.dryRun}
+}
+
+// Batch of queries sent to weaviate in a single request
+func (api *API) Batch() *BatchBuilder {
+	return &BatchBuilder{connection: api.connection}
 }
 
 // Raw creates a raw GraphQL query
 func (api *API) Raw() *Raw {
-	return &Raw{connection: api.connection}
+	return &Raw{connection: api.connection, strictErrors: api.strictErrors}
 }
 
 // NearTextArgBuilder nearText clause
@@ -121,17 +154,66 @@ type rest interface {
 	RunREST(ctx context.Context, path string, restMethod string, requestBody interface{}) (*connection.ResponseData, error)
 }
 
-func runGraphQLQuery(ctx context.Context, rest rest, query string) (*models.GraphQLResponse, error) {
-	// Do execute the GraphQL query
-	gqlQuery := models.GraphQLQuery{
-		Query: query,
+// dryRun hands the GraphQL queries to the hook instead of sending them
+type dryRun struct {
+	sync.Mutex
+	hook func(query *models.GraphQLQuery)
+}
+
+// RunREST calls the hook with the queries and answers with empty GraphQL responses
+func (d *dryRun) RunREST(_ context.Context, _ string, _ string, requestBody interface{}) (*connection.ResponseData, error) {
+	queries, isBatch := requestBody.(models.GraphQLQueries)
+	if query, ok := requestBody.(*models.GraphQLQuery); ok {
+		queries = models.GraphQLQueries{query}
+	}
+	d.Lock()
+	for _, query := range queries {
+		if d.hook != nil {
+			d.hook(query)
+		}
 	}
-	responseData, responseErr := rest.RunREST(ctx, "/graphql", http.MethodPost, &gqlQuery)
+	d.Unlock()
+	if isBatch {
+		body := strings.TrimSuffix(strings.Repeat(`{"data":{}},`, len(queries)), ",")
+		return &connection.ResponseData{StatusCode: http.StatusOK, Body: []byte("[" + body + "]")}, nil
+	}
+	return &connection.ResponseData{StatusCode: http.StatusOK, Body: []byte(`{"data":{}}`)}, nil
+}
+
+// streamingRest is implemented by connections which can hand out the response body as a stream
+type streamingRest interface {
+	// RunRESTStream request to weaviate and hand the response body to handle
+	RunRESTStream(ctx context.Context, path string, restMethod string, requestBody interface{},
+		handle func(statusCode int, body io.Reader) error) error
+}
+
+// runGraphQLQueryStream executes the query and hands the response body to handle. If the connection
+// is not able to stream, the response is read into memory first.
+func runGraphQLQueryStream(ctx context.Context, rest rest, query *models.GraphQLQuery,
+	handle func(statusCode int, body io.Reader) error,
+) error {
+	if streaming, ok := rest.(streamingRest); ok {
+		return streaming.RunRESTStream(ctx, "/graphql", http.MethodPost, query, handle)
+	}
+	responseData, responseErr := rest.RunREST(ctx, "/graphql", http.MethodPost, query)
+	if responseErr != nil {
+		return responseErr
+	}
+	return handle(responseData.StatusCode, bytes.NewReader(responseData.Body))
+}
+
+// runGraphQLQuery executes the query. If strictErrors is set, errors contained in the GraphQL
+// response are returned as *fault.GraphQLError together with the (partial) response.
+func runGraphQLQuery(ctx context.Context, rest rest, query *models.GraphQLQuery, strictErrors bool) (*models.GraphQLResponse, error) {
+	responseData, responseErr := rest.RunREST(ctx, "/graphql", http.MethodPost, query)
 	err := except.CheckResponseDataErrorAndStatusCode(responseData, responseErr, 200)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
 	}
 	var gqlResponse models.GraphQLResponse
 	parseErr := responseData.DecodeBodyIntoTarget(&gqlResponse)
+	if parseErr == nil && strictErrors && len(gqlResponse.Errors) > 0 {
+		return &gqlResponse, &fault.GraphQLError{Errors: gqlResponse.Errors}
+	}
 	return &gqlResponse, parseErr
 }
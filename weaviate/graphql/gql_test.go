@@ -0,0 +1,257 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/fault"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestStrictErrors(t *testing.T) {
+	partialResponse := func() *MockRunREST {
+		return &MockRunREST{
+			ReturnResponseData: &connection.ResponseData{
+				StatusCode: 200,
+				Body: []byte(`{"data":{"Get":{"Pizza":[{"name":"Hawaii"}]}},` +
+					`"errors":[{"message":"generative module failed","locations":[{"line":1,"column":10}],"path":["Get","Pizza"]}]}`),
+			},
+		}
+	}
+
+	t.Run("errors are only reported in the response by default", func(t *testing.T) {
+		api := &API{}
+		raw := api.Raw().WithQuery("{Get {Pizza {name}}}")
+		raw.connection = partialResponse()
+
+		resp, err := raw.Do(context.Background())
+		require.NoError(t, err)
+		require.Len(t, resp.Errors, 1)
+		assert.NotNil(t, resp.Data["Get"])
+	})
+
+	t.Run("strict api", func(t *testing.T) {
+		api := (&API{}).WithStrictErrors(true)
+		get := api.Get().WithClassName("Pizza").WithFields(Field{Name: "name"})
+		get.connection = partialResponse()
+
+		resp, err := get.Do(context.Background())
+		require.Error(t, err)
+		var gqlErr *fault.GraphQLError
+		require.True(t, errors.As(err, &gqlErr))
+		require.Len(t, gqlErr.Errors, 1)
+		assert.Equal(t, "generative module failed", gqlErr.Errors[0].Message)
+		assert.Equal(t, []string{"Get", "Pizza"}, gqlErr.Errors[0].Path)
+		require.Len(t, gqlErr.Errors[0].Locations, 1)
+		assert.Equal(t, int64(10), gqlErr.Errors[0].Locations[0].Column)
+		// partial data is still returned
+		require.NotNil(t, resp)
+		assert.NotNil(t, resp.Data["Get"])
+	})
+
+	t.Run("strict builder", func(t *testing.T) {
+		aggregate := (&API{}).Aggregate().WithClassName("Pizza").WithFields(Field{Name: "meta{count}"}).
+			WithStrictErrors(true)
+		aggregate.connection = partialResponse()
+
+		_, err := aggregate.Do(context.Background())
+		var gqlErr *fault.GraphQLError
+		assert.True(t, errors.As(err, &gqlErr))
+	})
+
+	t.Run("strict builder overrides the api", func(t *testing.T) {
+		explore := (&API{}).WithStrictErrors(true).Explore().WithStrictErrors(false)
+		explore.connection = partialResponse()
+
+		_, err := explore.Do(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func TestInjectionSafety(t *testing.T) {
+	malicious := `"}) {Get {Secret {token}}} #`
+	escaped := `"\"}) {Get {Secret {token}}} #"`
+
+	t.Run("user input is escaped", func(t *testing.T) {
+		get := (&API{}).Get().WithClassName("Pizza").WithFields(Field{Name: "name"}).
+			WithTenant(malicious).
+			WithAfter(malicious).
+			WithWhere(filters.TextProp(malicious).Equal(malicious)).
+			WithBM25((&BM25ArgumentBuilder{}).WithQuery(malicious).WithProperties(malicious)).
+			WithAsk((&AskArgumentBuilder{}).WithQuestion(malicious)).
+			WithNearObject((&NearObjectArgumentBuilder{}).WithID(malicious)).
+			WithSort(Sort{Path: []string{malicious}, Order: SortOrder(malicious)}).
+			WithGenerativeSearch(NewGenerativeSearch().SingleResult(malicious + "\n" + `"""`))
+		query := get.build()
+
+		assert.Contains(t, query, "tenant: "+escaped)
+		assert.Contains(t, query, "after: "+escaped)
+		assert.Contains(t, query, `where:{operator: Equal path: [`+escaped+`] valueText: `+escaped+`}`)
+		assert.Contains(t, query, `bm25:{query: `+escaped+`, properties: [`+escaped+`]}`)
+		assert.Contains(t, query, `ask:{question: `+escaped+`}`)
+		assert.Contains(t, query, `nearObject:{id: `+escaped+`}`)
+		assert.Contains(t, query, `sort:[{path:[`+escaped+`] order:`+escaped+`}]`)
+		assert.Contains(t, query, `generate(singleResult:{prompt:"\"}) {Get {Secret {token}}} #\n\"\"\""})`)
+	})
+
+	t.Run("mover objects are quoted", func(t *testing.T) {
+		mover := MoverObject{ID: malicious, Beacon: malicious}
+		assert.Equal(t, `{id: `+escaped+` beacon: `+escaped+`}`, mover.String())
+	})
+
+	t.Run("invalid class names are rejected", func(t *testing.T) {
+		conMock := &MockRunREST{}
+		get := (&API{}).Get().WithClassName("Pizza {name}} {Get {Secret").WithFields(Field{Name: "name"})
+		get.connection = conMock
+		_, err := get.Do(context.Background())
+		require.Error(t, err)
+		var clientErr *fault.WeaviateClientError
+		require.True(t, errors.As(err, &clientErr))
+		assert.Contains(t, clientErr.DerivedFromError.Error(), "invalid class name")
+		assert.Empty(t, conMock.ArgPath, "no request is sent")
+
+		aggregate := (&API{}).Aggregate().WithClassName("Pizza").WithGroupBy("name)").WithFields(Field{Name: "meta{count}"})
+		aggregate.connection = conMock
+		_, err = aggregate.Do(context.Background())
+		assert.Error(t, err)
+		assert.Empty(t, conMock.ArgPath, "no request is sent")
+	})
+	t.Run("fields which alter the query are rejected", func(t *testing.T) {
+		conMock := &MockRunREST{}
+		field := Field{Name: "name}} Secret{token"}
+
+		get := (&API{}).Get().WithClassName("Pizza").WithFields(Field{Name: "_additional", Fields: []Field{field}})
+		get.connection = conMock
+		_, err := get.Do(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid selection")
+
+		aggregate := (&API{}).Aggregate().WithClassName("Pizza").WithFields(field)
+		aggregate.connection = conMock
+		_, err = aggregate.Do(context.Background())
+		assert.Error(t, err)
+
+		explore := (&API{}).Explore().WithFields(ExploreFields("beacon} Secret{token"))
+		explore.connection = conMock
+		_, err = explore.Do(context.Background())
+		assert.Error(t, err)
+		assert.Empty(t, conMock.ArgPath, "no request is sent")
+
+		// nested selections, inline fragments and string arguments are still supported
+		get = (&API{}).Get().WithClassName("Pizza").
+			WithFields(Field{Name: "ofDocument{... on Document{_additional{id}}}"})
+		assert.NoError(t, get.validate())
+		get = (&API{}).Get().WithClassName("Pizza").
+			WithFields(Field{Name: `_additional{rerank(property:"name", query:"a \"b\" }"){score}}`})
+		assert.NoError(t, get.validate())
+	})
+}
+
+func TestBuildAndString(t *testing.T) {
+	api := &API{}
+	tests := []struct {
+		name    string
+		builder interface {
+			Build() *models.GraphQLQuery
+			String() string
+		}
+		query string
+	}{
+		{
+			name:    "get",
+			builder: api.Get().WithClassName("Pizza").WithFields(Field{Name: "name"}).WithLimit(2),
+			query:   `{Get {Pizza (limit: 2) {name}}}`,
+		},
+		{
+			name: "multi class get",
+			builder: api.MultiClassGet().
+				AddQueryClass(NewQueryClassBuilder("Pizza").WithFields(Field{Name: "name"})).
+				AddQueryClass(NewQueryClassBuilder("Soup").WithFields(Field{Name: "name"})),
+			query: `{Get {Pizza  {name} Soup  {name}}}`,
+		},
+		{
+			name:    "aggregate",
+			builder: api.Aggregate().WithClassName("Pizza").WithFields(Field{Name: "meta{count}"}),
+			query:   `{Aggregate{Pizza{meta{count}}}}`,
+		},
+		{
+			name:    "explore",
+			builder: api.Explore().WithFields(Beacon).WithLimit(1),
+			query:   `{Explore(limit: 1){beacon }}`,
+		},
+		{
+			name:    "raw",
+			builder: api.Raw().WithQuery(`{Get {Pizza {name}}}`),
+			query:   `{Get {Pizza {name}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.query, tt.builder.String())
+			assert.Equal(t, &models.GraphQLQuery{Query: tt.query}, tt.builder.Build())
+		})
+	}
+}
+
+func TestString_doesNotChangeTheBuilder(t *testing.T) {
+	var sent []*models.GraphQLQuery
+	api := (&API{}).WithDryRun(func(query *models.GraphQLQuery) {
+		sent = append(sent, query)
+	})
+	fields := make([]Field, 0, 4)
+	fields = append(fields, Field{Name: "_additional", Fields: []Field{{Name: "id"}}}, Field{Name: "name"})
+	get := api.Get().WithClassName("Pizza").WithFields(fields...).
+		WithGenerativeSearch(NewGenerativeSearch().SingleResult("Describe {name}"))
+
+	first := get.String()
+	assert.Equal(t, first, get.String())
+	_, err := get.Do(context.Background())
+	require.NoError(t, err)
+	require.Len(t, sent, 1)
+	assert.Equal(t, first, sent[0].Query)
+	assert.Equal(t, 1, strings.Count(first, "generate("))
+	assert.Equal(t, []Field{{Name: "_additional", Fields: []Field{{Name: "id"}}}, {Name: "name"}}, get.withFields)
+
+	// fields without _additional are not appended to in place either
+	get = api.Get().WithClassName("Pizza").WithFields(fields[1:]...).
+		WithGenerativeSearch(NewGenerativeSearch().SingleResult("Describe {name}"))
+	assert.Equal(t, get.String(), get.String())
+	assert.Len(t, fields[:cap(fields)][2].Fields, 0)
+}
+
+func TestDryRun(t *testing.T) {
+	var queries []*models.GraphQLQuery
+	api := (&API{}).WithDryRun(func(query *models.GraphQLQuery) {
+		queries = append(queries, query)
+	})
+
+	get := api.Get().WithClassName("Pizza").WithFields(Field{Name: "name"}).
+		WithBM25((&BM25ArgumentBuilder{}).WithQuery("hawaii")).WithQueryVariables(true)
+	resp, err := get.Do(context.Background())
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Empty(t, resp.Errors)
+
+	err = get.DoStream(context.Background(), func(object json.RawMessage) error {
+		t.Fatal("dry run returns no objects")
+		return nil
+	})
+	require.NoError(t, err)
+
+	_, err = api.WithStrictErrors(true).Aggregate().WithClassName("Pizza").WithFields(Field{Name: "meta{count}"}).
+		Do(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, queries, 3)
+	assert.Equal(t, get.Build(), queries[0])
+	assert.Equal(t, map[string]interface{}{"v0": "hawaii"}, queries[0].Variables)
+	assert.Equal(t, get.Build(), queries[1])
+	assert.Equal(t, `{Aggregate{Pizza{meta{count}}}}`, queries[2].Query)
+}
@@ -3,6 +3,8 @@ package graphql
 import (
 	"fmt"
 	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 )
 
 // GroupType filter
@@ -37,7 +39,7 @@ func (b *GroupArgumentBuilder) WithForce(force float32) *GroupArgumentBuilder {
 func (b *GroupArgumentBuilder) build() string {
 	clause := []string{}
 	if len(b.withType) > 0 {
-		clause = append(clause, fmt.Sprintf("type: %s", b.withType))
+		clause = append(clause, fmt.Sprintf("type: %s", gql.Enum(string(b.withType))))
 	}
 	if b.withForce {
 		clause = append(clause, fmt.Sprintf("force: %v", b.force))
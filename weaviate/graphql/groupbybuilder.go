@@ -1,9 +1,10 @@
 package graphql
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 )
 
 type GroupByArgumentBuilder struct {
@@ -12,6 +13,8 @@ type GroupByArgumentBuilder struct {
 	withGroups          bool
 	objectsPerGroup     int
 	withObjectsPerGroup bool
+	withHitFields       bool
+	hitFields           []Field
 }
 
 // WithPath the property by which is should be grouped by
@@ -34,12 +37,52 @@ func (b *GroupByArgumentBuilder) WithObjectsPerGroup(objectsPerGroup int) *Group
 	return b
 }
 
+// WithHitFields requests the group of each result in _additional, with the given fields of
+// its hits. The id and distance of the hits are always requested. The groups can be decoded
+// with DecodeGroups.
+func (b *GroupByArgumentBuilder) WithHitFields(fields ...Field) *GroupByArgumentBuilder {
+	b.withHitFields = true
+	b.hitFields = fields
+	return b
+}
+
+// additionalFields requested by the group by
+func (b *GroupByArgumentBuilder) additionalFields() []Field {
+	if !b.withHitFields {
+		return nil
+	}
+	hits := make([]Field, 0, len(b.hitFields)+1)
+	additional := Field{Name: "_additional"}
+	for _, field := range b.hitFields {
+		if field.Name == "_additional" {
+			additional.Fields = append(additional.Fields, field.Fields...)
+		} else {
+			hits = append(hits, field)
+		}
+	}
+	for _, name := range []string{"id", "distance"} {
+		if !containsField(additional.Fields, name) {
+			additional.Fields = append(additional.Fields, Field{Name: name})
+		}
+	}
+	hits = append(hits, additional)
+	return []Field{{
+		Name: "group",
+		Fields: []Field{
+			{Name: "id"},
+			{Name: "groupedBy", Fields: []Field{{Name: "value"}, {Name: "path"}}},
+			{Name: "count"},
+			{Name: "maxDistance"},
+			{Name: "minDistance"},
+			{Name: "hits", Fields: hits},
+		},
+	}}
+}
+
 // Build build the given clause
 func (b *GroupByArgumentBuilder) build() string {
 	clause := []string{}
-	path, _ := json.Marshal(b.path)
-
-	clause = append(clause, fmt.Sprintf("path:%s", path))
+	clause = append(clause, fmt.Sprintf("path:%s", gql.QuoteList(b.path)))
 	if b.withGroups {
 		clause = append(clause, fmt.Sprintf("groups:%v", b.groups))
 	}
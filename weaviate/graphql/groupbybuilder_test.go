@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGroupByBuilder_build(t *testing.T) {
@@ -36,3 +37,36 @@ func TestGroupByBuilder_build(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupByBuilder_hitFields(t *testing.T) {
+	t.Run("group selection merged into _additional", func(t *testing.T) {
+		builder := GetBuilder{connection: &MockRunREST{}}
+		groupBy := (&GroupByArgumentBuilder{}).WithPath([]string{"name"}).WithGroups(2).WithObjectsPerGroup(3).
+			WithHitFields(Field{Name: "name"})
+
+		query := builder.WithClassName("Pizza").WithGroupBy(groupBy).build()
+
+		expected := `{Get {Pizza (groupBy:{path:["name"] groups:2 objectsPerGroup:3}) {_additional{group{id groupedBy{value path} ` +
+			`count maxDistance minDistance hits{name _additional{id distance}}}}}}}`
+		assert.Equal(t, expected, query)
+	})
+
+	t.Run("own _additional of hits", func(t *testing.T) {
+		groupBy := (&GroupByArgumentBuilder{}).WithHitFields(Field{Name: "_additional", Fields: []Field{{Name: "vector"}}})
+
+		fields := groupBy.additionalFields()
+		assert.Equal(t, `group{id groupedBy{value path} count maxDistance minDistance `+
+			`hits{_additional{vector id distance}}}`, fields[0].build())
+	})
+
+	t.Run("no hit fields besides id and distance", func(t *testing.T) {
+		fields := (&GroupByArgumentBuilder{}).WithHitFields().additionalFields()
+		require.Len(t, fields, 1)
+		assert.Equal(t, `group{id groupedBy{value path} count maxDistance minDistance `+
+			`hits{_additional{id distance}}}`, fields[0].build())
+	})
+
+	t.Run("without hit fields", func(t *testing.T) {
+		assert.Empty(t, (&GroupByArgumentBuilder{}).WithPath([]string{"name"}).additionalFields())
+	})
+}
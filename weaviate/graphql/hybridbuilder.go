@@ -1,9 +1,11 @@
 package graphql
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 )
 
 type FusionType string
@@ -15,12 +17,20 @@ const Ranked FusionType = "rankedFusion"
 const RelativeScore FusionType = "relativeScoreFusion"
 
 type HybridArgumentBuilder struct {
-	query      string
-	vector     []float32
-	withAlpha  bool
-	alpha      float32
-	properties []string
-	fusionType FusionType
+	query         string
+	vector        []float32
+	withAlpha     bool
+	alpha         float32
+	properties    []string
+	fusionType    FusionType
+	targetVectors []string
+
+	nearText              *NearTextArgumentBuilder
+	nearVector            *NearVectorArgumentBuilder
+	withMaxVectorDistance bool
+	maxVectorDistance     float32
+	bm25SearchOperator    *SearchOperator
+	explainScore          bool
 }
 
 // WithQuery the search string
@@ -54,33 +64,119 @@ func (h *HybridArgumentBuilder) WithFusionType(fusionType FusionType) *HybridArg
 	return h
 }
 
+// WithTargetVectors the named vectors of the class which are searched, required for classes
+// with multiple named vectors
+func (h *HybridArgumentBuilder) WithTargetVectors(targetVectors ...string) *HybridArgumentBuilder {
+	h.targetVectors = targetVectors
+	return h
+}
+
+// WithNearText performs the vector part of the search as nearText search instead of
+// vectorizing the query. Can't be combined with WithVector or WithNearVector.
+func (h *HybridArgumentBuilder) WithNearText(nearText *NearTextArgumentBuilder) *HybridArgumentBuilder {
+	h.nearText = nearText
+	return h
+}
+
+// WithNearVector performs the vector part of the search as nearVector search, which allows
+// to limit it by certainty or distance. Can't be combined with WithVector or WithNearText.
+func (h *HybridArgumentBuilder) WithNearVector(nearVector *NearVectorArgumentBuilder) *HybridArgumentBuilder {
+	h.nearVector = nearVector
+	return h
+}
+
+// WithMaxVectorDistance excludes objects from the vector part of the search which are
+// further away from the query vector
+func (h *HybridArgumentBuilder) WithMaxVectorDistance(maxVectorDistance float32) *HybridArgumentBuilder {
+	h.withMaxVectorDistance = true
+	h.maxVectorDistance = maxVectorDistance
+	return h
+}
+
+// WithBM25SearchOperator defines how the tokens of the query are combined in the keyword
+// part of the search
+func (h *HybridArgumentBuilder) WithBM25SearchOperator(operator SearchOperator) *HybridArgumentBuilder {
+	h.bm25SearchOperator = &operator
+	return h
+}
+
+// WithExplainScore requests score and explainScore in _additional of the results of a
+// Get query, which can be decoded with Additional.ScoreComponents
+func (h *HybridArgumentBuilder) WithExplainScore(explainScore bool) *HybridArgumentBuilder {
+	h.explainScore = explainScore
+	return h
+}
+
+// additionalFields requested by the search
+func (h *HybridArgumentBuilder) additionalFields() []Field {
+	if !h.explainScore {
+		return nil
+	}
+	return []Field{{Name: "score"}, {Name: "explainScore"}}
+}
+
+// validate that the vector part of the search is defined only once
+func (h *HybridArgumentBuilder) validate() error {
+	if h.nearText != nil && h.nearVector != nil {
+		return errors.New("hybrid: nearText and nearVector can't be combined")
+	}
+	if len(h.vector) > 0 && (h.nearText != nil || h.nearVector != nil) {
+		return errors.New("hybrid: vector can't be combined with nearText or nearVector")
+	}
+	return nil
+}
+
+// hasThreshold reports whether the vector part of the search is limited by a maximum distance
+func (h *HybridArgumentBuilder) hasThreshold() bool {
+	return h.withMaxVectorDistance
+}
+
 // Build build the given clause
 func (h *HybridArgumentBuilder) build() string {
+	return h.buildWith(nil)
+}
+
+func (h *HybridArgumentBuilder) buildWith(vars *gql.Variables) string {
 	clause := []string{}
 	if h.query != "" {
-		clause = append(clause, fmt.Sprintf("query: %q", h.query))
+		clause = append(clause, fmt.Sprintf("query: %s", vars.Value("String", h.query)))
 	}
 	if len(h.vector) > 0 {
-		vectorB, err := json.Marshal(h.vector)
-		if err != nil {
-			panic(fmt.Errorf("failed to unmarshal hybrid search vector: %s", err))
-		}
-		clause = append(clause, fmt.Sprintf("vector: %s", string(vectorB)))
+		clause = append(clause, fmt.Sprintf("vector: %s", vars.Value("[Float]", h.vector)))
 	}
 	if h.withAlpha {
 		clause = append(clause, fmt.Sprintf("alpha: %v", h.alpha))
 	}
 
 	if len(h.properties) > 0 {
-		props, err := json.Marshal(h.properties)
-		if err != nil {
-			panic(fmt.Errorf("failed to unmarshal hybrid properties: %s", err))
-		}
-		clause = append(clause, fmt.Sprintf("properties: %v", string(props)))
+		clause = append(clause, fmt.Sprintf("properties: %s", gql.QuoteList(h.properties)))
 	}
 
 	if h.fusionType != "" {
-		clause = append(clause, fmt.Sprintf("fusionType: %s", h.fusionType))
+		clause = append(clause, fmt.Sprintf("fusionType: %s", gql.Enum(string(h.fusionType))))
+	}
+
+	if len(h.targetVectors) > 0 {
+		clause = append(clause, fmt.Sprintf("targetVectors: %s", gql.QuoteList(h.targetVectors)))
+	}
+
+	if h.withMaxVectorDistance {
+		clause = append(clause, fmt.Sprintf("maxVectorDistance: %v", h.maxVectorDistance))
+	}
+
+	if h.bm25SearchOperator != nil {
+		clause = append(clause, fmt.Sprintf("bm25SearchOperator: %s", h.bm25SearchOperator.build()))
+	}
+
+	searches := []string{}
+	if h.nearText != nil {
+		searches = append(searches, h.nearText.buildWith(vars))
+	}
+	if h.nearVector != nil {
+		searches = append(searches, h.nearVector.buildWith(vars))
+	}
+	if len(searches) > 0 {
+		clause = append(clause, fmt.Sprintf("searches: {%s}", strings.Join(searches, " ")))
 	}
 
 	return fmt.Sprintf("hybrid:{%v}", strings.Join(clause, ", "))
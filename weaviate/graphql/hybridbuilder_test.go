@@ -1,6 +1,7 @@
 package graphql
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -14,6 +15,44 @@ func TestHybridBuilder_build(t *testing.T) {
 		require.Equal(t, expected, str)
 	})
 
+	t.Run("query and target vectors", func(t *testing.T) {
+		hybrid := HybridArgumentBuilder{}
+		str := hybrid.WithQuery("query").WithTargetVectors("title").build()
+		expected := `hybrid:{query: "query", targetVectors: ["title"]}`
+		require.Equal(t, expected, str)
+	})
+
+	t.Run("query with nearText sub-search", func(t *testing.T) {
+		hybrid := HybridArgumentBuilder{}
+		nearText := (&NearTextArgumentBuilder{}).WithConcepts([]string{"pizza"}).WithDistance(0.3)
+		str := hybrid.WithQuery("query").WithNearText(nearText).build()
+		expected := `hybrid:{query: "query", searches: {nearText:{concepts: ["pizza"] distance: 0.3}}}`
+		require.Equal(t, expected, str)
+	})
+
+	t.Run("query with nearVector sub-search", func(t *testing.T) {
+		hybrid := HybridArgumentBuilder{}
+		nearVector := (&NearVectorArgumentBuilder{}).WithVector([]float32{1, 2}).WithCertainty(0.8)
+		str := hybrid.WithQuery("query").WithNearVector(nearVector).build()
+		expected := `hybrid:{query: "query", searches: {nearVector:{certainty: 0.8 vector: [1,2]}}}`
+		require.Equal(t, expected, str)
+	})
+
+	t.Run("query with max vector distance and search operator", func(t *testing.T) {
+		hybrid := HybridArgumentBuilder{}
+		str := hybrid.WithQuery("query").WithMaxVectorDistance(0.4).
+			WithBM25SearchOperator(SearchOperator{Operator: SearchOperatorOr, MinimumOrTokensMatch: 2}).build()
+		expected := `hybrid:{query: "query", maxVectorDistance: 0.4, bm25SearchOperator: {operator:Or minimumOrTokensMatch:2}}`
+		require.Equal(t, expected, str)
+	})
+
+	t.Run("explain score doesn't change the argument", func(t *testing.T) {
+		hybrid := HybridArgumentBuilder{}
+		str := hybrid.WithQuery("query").WithExplainScore(true).build()
+		expected := `hybrid:{query: "query"}`
+		require.Equal(t, expected, str)
+	})
+
 	t.Run("only query", func(t *testing.T) {
 		hybrid := HybridArgumentBuilder{}
 		str := hybrid.WithQuery("query").build()
@@ -59,3 +98,25 @@ func TestHybridBuilder_build(t *testing.T) {
 		require.Equal(t, expected, str)
 	})
 }
+
+func TestHybridBuilder_validate(t *testing.T) {
+	nearText := (&NearTextArgumentBuilder{}).WithConcepts([]string{"pizza"})
+	nearVector := (&NearVectorArgumentBuilder{}).WithVector([]float32{1, 2})
+
+	require.NoError(t, (&HybridArgumentBuilder{}).WithQuery("query").WithNearText(nearText).validate())
+	require.NoError(t, (&HybridArgumentBuilder{}).WithQuery("query").WithVector([]float32{1, 2}).validate())
+
+	err := (&HybridArgumentBuilder{}).WithQuery("query").WithNearText(nearText).WithNearVector(nearVector).validate()
+	require.EqualError(t, err, "hybrid: nearText and nearVector can't be combined")
+
+	err = (&HybridArgumentBuilder{}).WithQuery("query").WithVector([]float32{1, 2}).WithNearVector(nearVector).validate()
+	require.EqualError(t, err, "hybrid: vector can't be combined with nearText or nearVector")
+
+	conMock := &MockRunREST{}
+	get := (&API{}).Get().WithClassName("Pizza").WithFields(Field{Name: "name"}).
+		WithHybrid((&HybridArgumentBuilder{}).WithQuery("query").WithNearText(nearText).WithNearVector(nearVector))
+	get.connection = conMock
+	_, err = get.Do(context.Background())
+	require.Error(t, err)
+	require.Empty(t, conMock.ArgPath, "no request is sent")
+}
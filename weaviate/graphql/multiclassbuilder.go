@@ -6,12 +6,16 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
 type MultiClassBuilder struct {
-	connection    rest
-	classBuilders map[string]*GetBuilder
+	connection     rest
+	strictErrors   bool
+	queryVariables bool
+	classBuilders  map[string]*GetBuilder
 }
 
 // ClassName that should be queried
@@ -24,13 +28,60 @@ func (mb *MultiClassBuilder) AddQueryClass(class *GetBuilder) *MultiClassBuilder
 	return mb
 }
 
+// WithStrictErrors makes Do fail with a *fault.GraphQLError if the GraphQL response contains errors.
+// The partial data of the response is still returned next to the error.
+func (mb *MultiClassBuilder) WithStrictErrors(strict bool) *MultiClassBuilder {
+	mb.strictErrors = strict
+	return mb
+}
+
+// WithQueryVariables sends the search values of all classes as GraphQL variables
+// instead of inlining them into the query string, see GetBuilder.WithQueryVariables
+func (mb *MultiClassBuilder) WithQueryVariables(queryVariables bool) *MultiClassBuilder {
+	mb.queryVariables = queryVariables
+	return mb
+}
+
 // Do execute the GraphQL query
 func (mb *MultiClassBuilder) Do(ctx context.Context) (*models.GraphQLResponse, error) {
-	return runGraphQLQuery(ctx, mb.connection, mb.build())
+	if err := mb.validate(); err != nil {
+		return nil, except.NewDerivedWeaviateClientError(err)
+	}
+	return runGraphQLQuery(ctx, mb.connection, mb.buildQuery(), mb.strictErrors)
+}
+
+// validate the parts of the query which can't be escaped
+func (mb *MultiClassBuilder) validate() error {
+	for _, classBuilder := range mb.classBuilders {
+		if err := classBuilder.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // build the GraphQL query string (not needed when Do is executed)
 func (mb *MultiClassBuilder) build() string {
+	return mb.buildWith(nil)
+}
+
+// Build the GraphQL query as it is sent by Do, including the variables if enabled
+func (mb *MultiClassBuilder) Build() *models.GraphQLQuery {
+	return mb.buildQuery()
+}
+
+// String returns the GraphQL query string as it is sent by Do
+func (mb *MultiClassBuilder) String() string {
+	return mb.buildQuery().Query
+}
+
+// buildQuery with the values passed as variables if query variables are enabled
+func (mb *MultiClassBuilder) buildQuery() *models.GraphQLQuery {
+	vars := newVariables(mb.queryVariables)
+	return graphQLQuery(mb.buildWith(vars), vars)
+}
+
+func (mb *MultiClassBuilder) buildWith(vars *gql.Variables) string {
 	var query string
 	// sorting className to have consistent order in query
 	s := make([]string, 0, len(mb.classBuilders))
@@ -41,9 +92,9 @@ func (mb *MultiClassBuilder) build() string {
 	for _, className := range s {
 		filterClause := ""
 		if mb.classBuilders[className].includesFilterClause {
-			filterClause = mb.classBuilders[className].createFilterClause()
+			filterClause = mb.classBuilders[className].createFilterClause(vars)
 		}
-		fieldsClause := mb.classBuilders[className].createFieldsClause()
+		fieldsClause := mb.classBuilders[className].createFieldsClause(vars)
 		query += fmt.Sprintf("%v %v {%v}", className, filterClause, fieldsClause) + " "
 	}
 	query = strings.TrimSpace(query)
@@ -5,12 +5,13 @@ import (
 )
 
 type NearAudioArgumentBuilder struct {
-	audio        string
-	audioReader  io.Reader
-	hasCertainty bool
-	certainty    float32
-	hasDistance  bool
-	distance     float32
+	audio         string
+	audioReader   io.Reader
+	hasCertainty  bool
+	certainty     float32
+	hasDistance   bool
+	distance      float32
+	targetVectors []string
 }
 
 // WithAudio base64 encoded audio
@@ -39,6 +40,18 @@ func (b *NearAudioArgumentBuilder) WithDistance(distance float32) *NearAudioArgu
 	return b
 }
 
+// WithTargetVectors the named vectors of the class which are searched, required for classes
+// with multiple named vectors
+func (b *NearAudioArgumentBuilder) WithTargetVectors(targetVectors ...string) *NearAudioArgumentBuilder {
+	b.targetVectors = targetVectors
+	return b
+}
+
+// hasThreshold reports whether the search is limited by certainty or distance
+func (b *NearAudioArgumentBuilder) hasThreshold() bool {
+	return b.hasCertainty || b.hasDistance
+}
+
 // Build build the given clause
 func (b *NearAudioArgumentBuilder) build() string {
 	builder := &nearMediaArgumentBuilder{
@@ -53,5 +66,6 @@ func (b *NearAudioArgumentBuilder) build() string {
 	if b.hasDistance {
 		builder.withDistance(b.distance)
 	}
+	builder.targetVectors = b.targetVectors
 	return builder.build()
 }
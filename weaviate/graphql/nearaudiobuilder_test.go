@@ -104,6 +104,16 @@ func TestNearAudio(t *testing.T) {
 		assert.Equal(t, expected, nearAudio)
 	})
 
+	t.Run("from base64 with target vectors", func(t *testing.T) {
+		nearAudio := (&NearAudioArgumentBuilder{}).
+			WithAudio("iVBORw0KGgoAAAANS").
+			WithTargetVectors("audio", "transcript").
+			build()
+
+		expected := `nearAudio:{audio: "iVBORw0KGgoAAAANS" targetVectors: ["audio","transcript"]}`
+		assert.Equal(t, expected, nearAudio)
+	})
+
 	t.Run("empty", func(t *testing.T) {
 		nearAudio := (&NearAudioArgumentBuilder{}).build()
 
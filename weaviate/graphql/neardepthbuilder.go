@@ -5,12 +5,13 @@ import (
 )
 
 type NearDepthArgumentBuilder struct {
-	depth        string
-	depthReader  io.Reader
-	hasCertainty bool
-	certainty    float32
-	hasDistance  bool
-	distance     float32
+	depth         string
+	depthReader   io.Reader
+	hasCertainty  bool
+	certainty     float32
+	hasDistance   bool
+	distance      float32
+	targetVectors []string
 }
 
 // WithDepth base64 encoded depth
@@ -39,6 +40,18 @@ func (b *NearDepthArgumentBuilder) WithDistance(distance float32) *NearDepthArgu
 	return b
 }
 
+// WithTargetVectors the named vectors of the class which are searched, required for classes
+// with multiple named vectors
+func (b *NearDepthArgumentBuilder) WithTargetVectors(targetVectors ...string) *NearDepthArgumentBuilder {
+	b.targetVectors = targetVectors
+	return b
+}
+
+// hasThreshold reports whether the search is limited by certainty or distance
+func (b *NearDepthArgumentBuilder) hasThreshold() bool {
+	return b.hasCertainty || b.hasDistance
+}
+
 // Build build the given clause
 func (b *NearDepthArgumentBuilder) build() string {
 	builder := &nearMediaArgumentBuilder{
@@ -53,5 +66,6 @@ func (b *NearDepthArgumentBuilder) build() string {
 	if b.hasDistance {
 		builder.withDistance(b.distance)
 	}
+	builder.targetVectors = b.targetVectors
 	return builder.build()
 }
@@ -5,12 +5,13 @@ import (
 )
 
 type NearImageArgumentBuilder struct {
-	image        string
-	imageReader  io.Reader
-	hasCertainty bool
-	certainty    float32
-	hasDistance  bool
-	distance     float32
+	image         string
+	imageReader   io.Reader
+	hasCertainty  bool
+	certainty     float32
+	hasDistance   bool
+	distance      float32
+	targetVectors []string
 }
 
 // WithImage base64 encoded image
@@ -39,6 +40,18 @@ func (b *NearImageArgumentBuilder) WithDistance(distance float32) *NearImageArgu
 	return b
 }
 
+// WithTargetVectors the named vectors of the class which are searched, required for classes
+// with multiple named vectors
+func (b *NearImageArgumentBuilder) WithTargetVectors(targetVectors ...string) *NearImageArgumentBuilder {
+	b.targetVectors = targetVectors
+	return b
+}
+
+// hasThreshold reports whether the search is limited by certainty or distance
+func (b *NearImageArgumentBuilder) hasThreshold() bool {
+	return b.hasCertainty || b.hasDistance
+}
+
 // Build build the given clause
 func (b *NearImageArgumentBuilder) build() string {
 	builder := &nearMediaArgumentBuilder{
@@ -53,5 +66,6 @@ func (b *NearImageArgumentBuilder) build() string {
 	if b.hasDistance {
 		builder.withDistance(b.distance)
 	}
+	builder.targetVectors = b.targetVectors
 	return builder.build()
 }
@@ -5,12 +5,13 @@ import (
 )
 
 type NearImuArgumentBuilder struct {
-	imu          string
-	imuReader    io.Reader
-	hasCertainty bool
-	certainty    float32
-	hasDistance  bool
-	distance     float32
+	imu           string
+	imuReader     io.Reader
+	hasCertainty  bool
+	certainty     float32
+	hasDistance   bool
+	distance      float32
+	targetVectors []string
 }
 
 // WithImu base64 encoded imu
@@ -39,6 +40,18 @@ func (b *NearImuArgumentBuilder) WithDistance(distance float32) *NearImuArgument
 	return b
 }
 
+// WithTargetVectors the named vectors of the class which are searched, required for classes
+// with multiple named vectors
+func (b *NearImuArgumentBuilder) WithTargetVectors(targetVectors ...string) *NearImuArgumentBuilder {
+	b.targetVectors = targetVectors
+	return b
+}
+
+// hasThreshold reports whether the search is limited by certainty or distance
+func (b *NearImuArgumentBuilder) hasThreshold() bool {
+	return b.hasCertainty || b.hasDistance
+}
+
 // Build build the given clause
 func (b *NearImuArgumentBuilder) build() string {
 	builder := &nearMediaArgumentBuilder{
@@ -53,5 +66,6 @@ func (b *NearImuArgumentBuilder) build() string {
 	if b.hasDistance {
 		builder.withDistance(b.distance)
 	}
+	builder.targetVectors = b.targetVectors
 	return builder.build()
 }
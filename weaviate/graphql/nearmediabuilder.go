@@ -5,21 +5,38 @@ import (
 	"fmt"
 	"io"
 	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 )
 
 type argumentBuilder interface {
 	build() string
 }
 
+// parameterizedArgumentBuilder is implemented by argument builders which are able to
+// pass their values as variables of a parameterized query
+type parameterizedArgumentBuilder interface {
+	buildWith(vars *gql.Variables) string
+}
+
+// buildArgument with the values passed as variables if vars is not nil and the builder supports it
+func buildArgument(b argumentBuilder, vars *gql.Variables) string {
+	if parameterized, ok := b.(parameterizedArgumentBuilder); ok {
+		return parameterized.buildWith(vars)
+	}
+	return b.build()
+}
+
 type nearMediaArgumentBuilder struct {
-	mediaName    string
-	mediaField   string
-	data         string
-	dataReader   io.Reader
-	hasCertainty bool
-	certainty    float32
-	hasDistance  bool
-	distance     float32
+	mediaName     string
+	mediaField    string
+	data          string
+	dataReader    io.Reader
+	hasCertainty  bool
+	certainty     float32
+	hasDistance   bool
+	distance      float32
+	targetVectors []string
 }
 
 func (b *nearMediaArgumentBuilder) withCertainty(certainty float32) *nearMediaArgumentBuilder {
@@ -53,7 +70,7 @@ func (b *nearMediaArgumentBuilder) getContent() string {
 func (b *nearMediaArgumentBuilder) build() string {
 	clause := []string{}
 	if content := b.getContent(); content != "" {
-		clause = append(clause, fmt.Sprintf("%s: \"%s\"", b.mediaField, content))
+		clause = append(clause, fmt.Sprintf("%s: %s", b.mediaField, gql.Quote(content)))
 	}
 	if b.hasCertainty {
 		clause = append(clause, fmt.Sprintf("certainty: %v", b.certainty))
@@ -61,5 +78,8 @@ func (b *nearMediaArgumentBuilder) build() string {
 	if b.hasDistance {
 		clause = append(clause, fmt.Sprintf("distance: %v", b.distance))
 	}
+	if len(b.targetVectors) > 0 {
+		clause = append(clause, fmt.Sprintf("targetVectors: %s", gql.QuoteList(b.targetVectors)))
+	}
 	return fmt.Sprintf("%s:{%s}", b.mediaName, strings.Join(clause, " "))
 }
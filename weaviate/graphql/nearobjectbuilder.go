@@ -3,6 +3,8 @@ package graphql
 import (
 	"fmt"
 	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 )
 
 type NearObjectArgumentBuilder struct {
@@ -12,6 +14,7 @@ type NearObjectArgumentBuilder struct {
 	certainty     float32
 	withDistance  bool
 	distance      float32
+	targetVectors []string
 }
 
 // WithID the id of the object
@@ -40,14 +43,26 @@ func (e *NearObjectArgumentBuilder) WithDistance(distance float32) *NearObjectAr
 	return e
 }
 
+// WithTargetVectors the named vectors of the class which are searched, required for classes
+// with multiple named vectors
+func (e *NearObjectArgumentBuilder) WithTargetVectors(targetVectors ...string) *NearObjectArgumentBuilder {
+	e.targetVectors = targetVectors
+	return e
+}
+
+// hasThreshold reports whether the search is limited by certainty or distance
+func (e *NearObjectArgumentBuilder) hasThreshold() bool {
+	return e.withCertainty || e.withDistance
+}
+
 // Build build the given clause
 func (e *NearObjectArgumentBuilder) build() string {
 	clause := []string{}
 	if len(e.id) > 0 {
-		clause = append(clause, fmt.Sprintf("id: \"%s\"", e.id))
+		clause = append(clause, fmt.Sprintf("id: %s", gql.Quote(e.id)))
 	}
 	if len(e.beacon) > 0 {
-		clause = append(clause, fmt.Sprintf("beacon: \"%s\"", e.beacon))
+		clause = append(clause, fmt.Sprintf("beacon: %s", gql.Quote(e.beacon)))
 	}
 	if e.withCertainty {
 		clause = append(clause, fmt.Sprintf("certainty: %v", e.certainty))
@@ -55,5 +70,8 @@ func (e *NearObjectArgumentBuilder) build() string {
 	if e.withDistance {
 		clause = append(clause, fmt.Sprintf("distance: %v", e.distance))
 	}
+	if len(e.targetVectors) > 0 {
+		clause = append(clause, fmt.Sprintf("targetVectors: %s", gql.QuoteList(e.targetVectors)))
+	}
 	return fmt.Sprintf("nearObject:{%s}", strings.Join(clause, " "))
 }
@@ -1,9 +1,10 @@
 package graphql
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 )
 
 // fldMover is a type representing field names of a move sub query
@@ -28,7 +29,7 @@ type MoveParameters struct {
 }
 
 func (m *MoveParameters) String() string {
-	concepts, _ := json.Marshal(m.Concepts)
+	concepts := gql.QuoteList(m.Concepts)
 	ms := make([]string, 0, len(m.Objects))
 	for _, m := range m.Objects {
 		if s := m.String(); s != EmptyObjectStr {
@@ -57,13 +58,13 @@ type MoverObject struct {
 // It returns EmptyObjectStr if both fields are empty
 func (m *MoverObject) String() string {
 	if m.ID != "" && m.Beacon != "" {
-		return fmt.Sprintf(`{%s: "%s" %s: "%s"}`, fldMoverID, m.ID, fldMoverBeacon, m.Beacon)
+		return fmt.Sprintf(`{%s: %s %s: %s}`, fldMoverID, gql.Quote(m.ID), fldMoverBeacon, gql.Quote(m.Beacon))
 	}
 	if m.ID != "" {
-		return fmt.Sprintf(`{%s: "%s"}`, fldMoverID, m.ID)
+		return fmt.Sprintf(`{%s: %s}`, fldMoverID, gql.Quote(m.ID))
 	}
 	if m.Beacon != "" {
-		return fmt.Sprintf(`{%s: "%s"}`, fldMoverBeacon, m.Beacon)
+		return fmt.Sprintf(`{%s: %s}`, fldMoverBeacon, gql.Quote(m.Beacon))
 	}
 	return EmptyObjectStr
 }
@@ -78,6 +79,7 @@ type NearTextArgumentBuilder struct {
 	moveAwayFrom    *MoveParameters
 	withAutocorrect bool
 	autocorrect     bool
+	targetVectors   []string
 }
 
 // WithConcepts the result is based on
@@ -119,12 +121,31 @@ func (e *NearTextArgumentBuilder) WithAutocorrect(autocorrect bool) *NearTextArg
 	return e
 }
 
+// hasThreshold reports whether the search is limited by certainty or distance
+func (e *NearTextArgumentBuilder) hasThreshold() bool {
+	return e.withCertainty || e.withDistance
+}
+
 // Build build the given clause
+// WithTargetVectors the named vectors of the class which are searched, required for classes
+// with multiple named vectors
+func (e *NearTextArgumentBuilder) WithTargetVectors(targetVectors ...string) *NearTextArgumentBuilder {
+	e.targetVectors = targetVectors
+	return e
+}
+
 func (e *NearTextArgumentBuilder) build() string {
+	return e.buildWith(nil)
+}
+
+func (e *NearTextArgumentBuilder) buildWith(vars *gql.Variables) string {
 	clause := []string{}
-	concepts, _ := json.Marshal(e.concepts)
+	concepts := e.concepts
+	if concepts == nil {
+		concepts = []string{}
+	}
 
-	clause = append(clause, fmt.Sprintf("concepts: %s", concepts))
+	clause = append(clause, fmt.Sprintf("concepts: %s", vars.Value("[String]!", concepts)))
 	if e.withCertainty {
 		clause = append(clause, fmt.Sprintf("certainty: %v", e.certainty))
 	}
@@ -140,5 +161,8 @@ func (e *NearTextArgumentBuilder) build() string {
 	if e.withAutocorrect {
 		clause = append(clause, fmt.Sprintf("autocorrect: %v", e.autocorrect))
 	}
+	if len(e.targetVectors) > 0 {
+		clause = append(clause, fmt.Sprintf("targetVectors: %s", gql.QuoteList(e.targetVectors)))
+	}
 	return fmt.Sprintf("nearText:{%v}", strings.Join(clause, " "))
 }
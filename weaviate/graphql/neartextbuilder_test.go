@@ -23,4 +23,12 @@ func Test_Neartextbuilder(t *testing.T) {
 		expected := `nearText:{concepts: ["\"I'm a complex concept\" says the string","simple concept"] moveAwayFrom: {concepts: ["Extra quotes: \" ':","no quotes"] force: 0}}`
 		require.Equal(t, expected, str)
 	})
+
+	t.Run("target vectors", func(t *testing.T) {
+		nt := NearTextArgumentBuilder{}
+
+		str := nt.WithConcepts([]string{"pizza"}).WithTargetVectors("title", "description").build()
+		expected := `nearText:{concepts: ["pizza"] targetVectors: ["title","description"]}`
+		require.Equal(t, expected, str)
+	})
 }
@@ -11,6 +11,7 @@ type NearThermalArgumentBuilder struct {
 	certainty     float32
 	hasDistance   bool
 	distance      float32
+	targetVectors []string
 }
 
 // WithThermal base64 encoded thermal
@@ -39,6 +40,18 @@ func (b *NearThermalArgumentBuilder) WithDistance(distance float32) *NearThermal
 	return b
 }
 
+// WithTargetVectors the named vectors of the class which are searched, required for classes
+// with multiple named vectors
+func (b *NearThermalArgumentBuilder) WithTargetVectors(targetVectors ...string) *NearThermalArgumentBuilder {
+	b.targetVectors = targetVectors
+	return b
+}
+
+// hasThreshold reports whether the search is limited by certainty or distance
+func (b *NearThermalArgumentBuilder) hasThreshold() bool {
+	return b.hasCertainty || b.hasDistance
+}
+
 // Build build the given clause
 func (b *NearThermalArgumentBuilder) build() string {
 	builder := &nearMediaArgumentBuilder{
@@ -53,5 +66,6 @@ func (b *NearThermalArgumentBuilder) build() string {
 	if b.hasDistance {
 		builder.withDistance(b.distance)
 	}
+	builder.targetVectors = b.targetVectors
 	return builder.build()
 }
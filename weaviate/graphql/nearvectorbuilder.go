@@ -1,9 +1,10 @@
 package graphql
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 )
 
 type NearVectorArgumentBuilder struct {
@@ -12,6 +13,7 @@ type NearVectorArgumentBuilder struct {
 	certainty     float32
 	withDistance  bool
 	distance      float32
+	targetVectors []string
 }
 
 // WithVector sets the search vector to be used in query
@@ -34,8 +36,24 @@ func (b *NearVectorArgumentBuilder) WithDistance(distance float32) *NearVectorAr
 	return b
 }
 
+// WithTargetVectors the named vectors of the class which are searched, required for classes
+// with multiple named vectors
+func (b *NearVectorArgumentBuilder) WithTargetVectors(targetVectors ...string) *NearVectorArgumentBuilder {
+	b.targetVectors = targetVectors
+	return b
+}
+
+// hasThreshold reports whether the search is limited by certainty or distance
+func (b *NearVectorArgumentBuilder) hasThreshold() bool {
+	return b.withCertainty || b.withDistance
+}
+
 // Build build the given clause
 func (b *NearVectorArgumentBuilder) build() string {
+	return b.buildWith(nil)
+}
+
+func (b *NearVectorArgumentBuilder) buildWith(vars *gql.Variables) string {
 	clause := []string{}
 	if b.withCertainty {
 		clause = append(clause, fmt.Sprintf("certainty: %v", b.certainty))
@@ -44,11 +62,10 @@ func (b *NearVectorArgumentBuilder) build() string {
 		clause = append(clause, fmt.Sprintf("distance: %v", b.distance))
 	}
 	if len(b.vector) != 0 {
-		vectorB, err := json.Marshal(b.vector)
-		if err != nil {
-			panic(fmt.Errorf("failed to unmarshal nearVector search vector: %s", err))
-		}
-		clause = append(clause, fmt.Sprintf("vector: %s", string(vectorB)))
+		clause = append(clause, fmt.Sprintf("vector: %s", vars.Value("[Float]!", b.vector)))
+	}
+	if len(b.targetVectors) > 0 {
+		clause = append(clause, fmt.Sprintf("targetVectors: %s", gql.QuoteList(b.targetVectors)))
 	}
 	return fmt.Sprintf("nearVector:{%v}", strings.Join(clause, " "))
 }
@@ -8,13 +8,23 @@ import (
 
 // Raw for accepting a prebuilt query from the user
 type Raw struct {
-	connection rest
-	query      string
+	connection    rest
+	strictErrors  bool
+	query         string
+	variables     map[string]interface{}
+	operationName string
+}
+
+// WithStrictErrors makes Do fail with a *fault.GraphQLError if the GraphQL response contains errors.
+// The partial data of the response is still returned next to the error.
+func (gql *Raw) WithStrictErrors(strict bool) *Raw {
+	gql.strictErrors = strict
+	return gql
 }
 
 // Do execute the GraphQL query
 func (gql *Raw) Do(ctx context.Context) (*models.GraphQLResponse, error) {
-	return runGraphQLQuery(ctx, gql.connection, gql.build())
+	return runGraphQLQuery(ctx, gql.connection, gql.buildQuery(), gql.strictErrors)
 }
 
 // WithQuery the query string
@@ -23,7 +33,38 @@ func (b *Raw) WithQuery(query string) *Raw {
 	return b
 }
 
+// WithVariables the values of the variables defined in the query, e.g. {"vector": []float32{...}}
+// for a query like `query($vector: [Float]!) {Get {Pizza(nearVector: {vector: $vector}) {name}}}`
+func (b *Raw) WithVariables(variables map[string]interface{}) *Raw {
+	b.variables = variables
+	return b
+}
+
+// WithOperationName selects the operation to execute if the query contains multiple operations
+func (b *Raw) WithOperationName(operationName string) *Raw {
+	b.operationName = operationName
+	return b
+}
+
 // return the query string
 func (gql *Raw) build() string {
 	return gql.query
 }
+
+// Build the GraphQL query as it is sent by Do, including its variables and operation name
+func (gql *Raw) Build() *models.GraphQLQuery {
+	return gql.buildQuery()
+}
+
+// String returns the GraphQL query string as it is sent by Do
+func (gql *Raw) String() string {
+	return gql.buildQuery().Query
+}
+
+func (gql *Raw) buildQuery() *models.GraphQLQuery {
+	query := &models.GraphQLQuery{Query: gql.query, OperationName: gql.operationName}
+	if len(gql.variables) > 0 {
+		query.Variables = gql.variables
+	}
+	return query
+}
@@ -0,0 +1,59 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
+)
+
+// RerankBuilder requests the rerank score of the reranker module configured for the class,
+// which ranks the results of the search by the relevance of property to query
+type RerankBuilder struct {
+	property string
+	query    string
+}
+
+// NewRerank returns a builder for the rerank field of _additional
+func NewRerank() *RerankBuilder {
+	return &RerankBuilder{}
+}
+
+// WithProperty whose content is ranked, required by weaviate
+func (rb *RerankBuilder) WithProperty(property string) *RerankBuilder {
+	rb.property = property
+	return rb
+}
+
+// WithQuery the property is ranked against, if not set the query of the search is used
+func (rb *RerankBuilder) WithQuery(query string) *RerankBuilder {
+	rb.query = query
+	return rb
+}
+
+// validate that the required property is set
+func (rb *RerankBuilder) validate() error {
+	if rb.property == "" {
+		return errors.New("rerank: property is required")
+	}
+	return nil
+}
+
+func (rb *RerankBuilder) build() Field {
+	return rb.buildWith(nil)
+}
+
+func (rb *RerankBuilder) buildWith(vars *gql.Variables) Field {
+	args := []string{}
+	if rb.property != "" {
+		args = append(args, fmt.Sprintf("property:%s", vars.Value("String", rb.property)))
+	}
+	if rb.query != "" {
+		args = append(args, fmt.Sprintf("query:%s", vars.Value("String", rb.query)))
+	}
+	return Field{
+		Name:   fmt.Sprintf("rerank(%s)", strings.Join(args, " ")),
+		Fields: []Field{{Name: "score"}},
+	}
+}
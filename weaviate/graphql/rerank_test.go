@@ -0,0 +1,48 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
+)
+
+func TestRerank_build(t *testing.T) {
+	t.Run("property and query", func(t *testing.T) {
+		result := NewRerank().WithProperty("description").WithQuery("Who is the author of \"Dune\"?").build()
+
+		assert.Equal(t, `rerank(property:"description" query:"Who is the author of \"Dune\"?")`, result.Name)
+		assert.Equal(t, []Field{{Name: "score"}}, result.Fields)
+	})
+
+	t.Run("property only", func(t *testing.T) {
+		result := NewRerank().WithProperty("description").build()
+
+		assert.Equal(t, `rerank(property:"description")`, result.Name)
+	})
+
+	t.Run("with variables", func(t *testing.T) {
+		vars := gql.NewVariables()
+		result := NewRerank().WithProperty("description").WithQuery("dune").buildWith(vars)
+
+		assert.Equal(t, `rerank(property:$v0 query:$v1)`, result.Name)
+		assert.Equal(t, map[string]interface{}{"v0": "description", "v1": "dune"}, vars.Values())
+	})
+}
+
+func TestRerank_validate(t *testing.T) {
+	conMock := &MockRunREST{}
+	get := (&API{}).Get().WithClassName("Book").WithFields(Field{Name: "title"}).
+		WithRerank(NewRerank().WithQuery("dune"))
+	get.connection = conMock
+
+	_, err := get.Do(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rerank: property is required")
+	assert.Empty(t, conMock.ArgPath, "no request is sent")
+
+	get.WithRerank(NewRerank().WithProperty("title"))
+	assert.NoError(t, get.validate())
+}
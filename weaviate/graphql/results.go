@@ -0,0 +1,226 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Additional holds the _additional properties of an object returned by a Get query. Add it
+// to the struct the objects are decoded into with the tag `json:"_additional"`.
+type Additional struct {
+	ID                 string               `json:"id,omitempty"`
+	Vector             []float32            `json:"vector,omitempty"`
+	Vectors            map[string][]float32 `json:"vectors,omitempty"`
+	Distance           *float32             `json:"distance,omitempty"`
+	Certainty          *float64             `json:"certainty,omitempty"`
+	CreationTimeUnix   string               `json:"creationTimeUnix,omitempty"`
+	LastUpdateTimeUnix string               `json:"lastUpdateTimeUnix,omitempty"`
+	Rerank             []RerankResult       `json:"rerank,omitempty"`
+	Generate           *GenerateResult      `json:"generate,omitempty"`
+	Group              *Group               `json:"group,omitempty"`
+	// Score of a bm25 or hybrid search, weaviate returns it as string
+	Score string `json:"score,omitempty"`
+	// ExplainScore of a bm25 or hybrid search, ScoreComponents parses the one of hybrid searches
+	ExplainScore string `json:"explainScore,omitempty"`
+}
+
+// RerankResult of the reranker module requested with GetBuilder.WithRerank
+type RerankResult struct {
+	Score *float64 `json:"score"`
+}
+
+// GenerateResult of the generative search requested with GetBuilder.WithGenerativeSearch.
+// The grouped result is only set for the first object of the results.
+type GenerateResult struct {
+	SingleResult  *string        `json:"singleResult"`
+	GroupedResult *string        `json:"groupedResult"`
+	Error         *string        `json:"error"`
+	Debug         *GenerateDebug `json:"debug,omitempty"`
+}
+
+// GenerateDebug information requested with GenerativeSearchBuilder.WithDebug
+type GenerateDebug struct {
+	Prompt string `json:"prompt"`
+}
+
+// Err returns the error the provider reported for the object or nil
+func (g *GenerateResult) Err() error {
+	if g == nil || g.Error == nil || *g.Error == "" {
+		return nil
+	}
+	return fmt.Errorf("generative search: %s", *g.Error)
+}
+
+// RerankScore returns the score the reranker module assigned to the object and whether
+// there is one
+func (a *Additional) RerankScore() (float64, bool) {
+	if a == nil || len(a.Rerank) == 0 || a.Rerank[0].Score == nil {
+		return 0, false
+	}
+	return *a.Rerank[0].Score, true
+}
+
+// ScoreValue returns the score of a bm25 or hybrid search as number and whether there is one
+func (a *Additional) ScoreValue() (float64, bool) {
+	if a == nil || a.Score == "" {
+		return 0, false
+	}
+	score, err := strconv.ParseFloat(a.Score, 64)
+	if err != nil {
+		return 0, false
+	}
+	return score, true
+}
+
+// ScoreComponents returns the per result set scores of a hybrid search, see ParseExplainScore
+func (a *Additional) ScoreComponents() []ScoreComponent {
+	if a == nil {
+		return nil
+	}
+	return ParseExplainScore(a.ExplainScore)
+}
+
+// ScoreComponent is the part of the score of a hybrid search result contributed by one of
+// its result sets, i.e. the keyword or the vector search
+type ScoreComponent struct {
+	// Source of the score, e.g. "keyword,bm25" and "vector,hybridVector", or "bm25" and
+	// "vector" for older weaviate versions. Empty if weaviate didn't report it.
+	Source string
+	// OriginalScore of the result set, only reported with relative score fusion
+	OriginalScore *float64
+	// NormalizedScore of the result set, only reported with relative score fusion. Weaviate
+	// weights it by alpha for the vector and by 1-alpha for the keyword search when fusing the
+	// scores, so it is not the contribution of the result set to the score of the object.
+	NormalizedScore *float64
+	// Contribution of the result set to the score of the object, only reported with ranked fusion
+	Contribution *float64
+}
+
+var (
+	explainScoreRe  = regexp.MustCompile(`original score (-?[0-9.]+(?:[eE][-+]?[0-9]+)?), normalized score: (-?[0-9.]+(?:[eE][-+]?[0-9]+)?)|contributed (-?[0-9.]+(?:[eE][-+]?[0-9]+)?) to the score`)
+	explainSourceRe = regexp.MustCompile(`\(Result Set ([^)]*)\)|\((bm25|vector)\)`)
+)
+
+// ParseExplainScore parses the explainScore of a hybrid search result into the scores of its
+// result sets. It understands the explanations of relative score fusion and ranked fusion,
+// unknown explanations result in no components.
+func ParseExplainScore(explainScore string) []ScoreComponent {
+	var components []ScoreComponent
+	start := 0
+	for _, match := range explainScoreRe.FindAllStringSubmatchIndex(explainScore, -1) {
+		component := ScoreComponent{}
+		sources := explainSourceRe.FindAllStringSubmatch(explainScore[start:match[0]], -1)
+		if len(sources) > 0 {
+			source := sources[len(sources)-1]
+			component.Source = source[1] + source[2]
+		}
+		value := func(group int) float64 {
+			number, _ := strconv.ParseFloat(explainScore[match[2*group]:match[2*group+1]], 64)
+			return number
+		}
+		if match[2] >= 0 {
+			original, normalized := value(1), value(2)
+			component.OriginalScore = &original
+			component.NormalizedScore = &normalized
+		} else {
+			contribution := value(3)
+			component.Contribution = &contribution
+		}
+		components = append(components, component)
+		start = match[1]
+	}
+	return components
+}
+
+// Group of a Get query with GetBuilder.WithGroupBy, see GroupByArgumentBuilder.WithHitFields
+type Group struct {
+	ID          int       `json:"id"`
+	GroupedBy   GroupedBy `json:"groupedBy"`
+	Count       int       `json:"count"`
+	MaxDistance float32   `json:"maxDistance"`
+	MinDistance float32   `json:"minDistance"`
+	// Hits of the group, which can be decoded with DecodeHits
+	Hits json.RawMessage `json:"hits"`
+}
+
+// GroupedBy is the value of the property the group was formed by
+type GroupedBy struct {
+	Value string   `json:"value"`
+	Path  []string `json:"path"`
+}
+
+// DecodeHits decodes the hits of the group into target, which must be a pointer to a slice.
+// The id and distance of the hits can be decoded with Additional.
+func (g *Group) DecodeHits(target interface{}) error {
+	if len(g.Hits) == 0 || string(g.Hits) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(g.Hits, target); err != nil {
+		return fmt.Errorf("decode hits of group %d: %w", g.ID, err)
+	}
+	return nil
+}
+
+// DecodeGroups returns the groups of a Get query of className with GetBuilder.WithGroupBy.
+// The group selection must be requested, e.g. with GroupByArgumentBuilder.WithHitFields.
+func DecodeGroups(response *models.GraphQLResponse, className string) ([]Group, error) {
+	var objects []struct {
+		Additional struct {
+			Group *Group `json:"group"`
+		} `json:"_additional"`
+	}
+	if err := DecodeObjects(response, className, &objects); err != nil {
+		return nil, err
+	}
+	groups := make([]Group, 0, len(objects))
+	for i := range objects {
+		if objects[i].Additional.Group == nil {
+			return nil, fmt.Errorf("decode groups: result %d of class %q has no group", i, className)
+		}
+		groups = append(groups, *objects[i].Additional.Group)
+	}
+	return groups, nil
+}
+
+// DecodeObjects decodes the objects of className returned by a Get query into target, which
+// must be a pointer to a slice, e.g. *[]Pizza. The fields of the target's element type are
+// matched by their json tags, _additional properties can be decoded with Additional.
+func DecodeObjects(response *models.GraphQLResponse, className string, target interface{}) error {
+	get, err := responseData(response, "Get")
+	if err != nil {
+		return err
+	}
+	objects, ok := get[className]
+	if !ok {
+		return fmt.Errorf("decode objects: no objects of class %q in response", className)
+	}
+	return decodeJSON(objects, target)
+}
+
+// responseData returns the data of the response for the query type, e.g. Get or Aggregate
+func responseData(response *models.GraphQLResponse, queryType string) (map[string]interface{}, error) {
+	if response == nil {
+		return nil, fmt.Errorf("decode %s response: response is nil", queryType)
+	}
+	data, ok := response.Data[queryType].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("decode %s response: no %s data in response", queryType, queryType)
+	}
+	return data, nil
+}
+
+// decodeJSON decodes the generically decoded value into target by encoding it again
+func decodeJSON(value interface{}, target interface{}) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package graphql
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestDecodeObjects(t *testing.T) {
+	type pizza struct {
+		Name       string     `json:"name"`
+		Additional Additional `json:"_additional"`
+	}
+
+	var response models.GraphQLResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"data":{"Get":{"Pizza":[
+		{"name":"Hawaii","_additional":{"id":"5b6a08ba-1d46-43aa-89cc-8b070790c6f2","distance":0.25,"rerank":[{"score":0.9}]}},
+		{"name":"Doener","_additional":{"rerank":[{"score":null}]}}
+	]}}}`), &response))
+
+	t.Run("objects with additional properties", func(t *testing.T) {
+		var pizzas []pizza
+		require.NoError(t, DecodeObjects(&response, "Pizza", &pizzas))
+		require.Len(t, pizzas, 2)
+
+		assert.Equal(t, "Hawaii", pizzas[0].Name)
+		assert.Equal(t, "5b6a08ba-1d46-43aa-89cc-8b070790c6f2", pizzas[0].Additional.ID)
+		require.NotNil(t, pizzas[0].Additional.Distance)
+		assert.Equal(t, float32(0.25), *pizzas[0].Additional.Distance)
+		score, ok := pizzas[0].Additional.RerankScore()
+		assert.True(t, ok)
+		assert.Equal(t, 0.9, score)
+
+		_, ok = pizzas[1].Additional.RerankScore()
+		assert.False(t, ok)
+	})
+
+	t.Run("missing class", func(t *testing.T) {
+		var pizzas []pizza
+		assert.Error(t, DecodeObjects(&response, "Soup", &pizzas))
+	})
+
+	t.Run("no Get data", func(t *testing.T) {
+		var pizzas []pizza
+		assert.Error(t, DecodeObjects(&models.GraphQLResponse{}, "Pizza", &pizzas))
+		assert.Error(t, DecodeObjects(nil, "Pizza", &pizzas))
+	})
+}
+
+func TestDecodeObjects_Generate(t *testing.T) {
+	type pizza struct {
+		Name       string     `json:"name"`
+		Additional Additional `json:"_additional"`
+	}
+
+	var response models.GraphQLResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"data":{"Get":{"Pizza":[
+		{"name":"Hawaii","_additional":{"generate":{"singleResult":"Sweet","groupedResult":"Both are round","error":null,"debug":{"prompt":"Describe Hawaii"}}}},
+		{"name":"Doener","_additional":{"generate":{"singleResult":null,"groupedResult":null,"error":"rate limit exceeded"}}}
+	]}}}`), &response))
+
+	var pizzas []pizza
+	require.NoError(t, DecodeObjects(&response, "Pizza", &pizzas))
+	require.Len(t, pizzas, 2)
+
+	generate := pizzas[0].Additional.Generate
+	require.NotNil(t, generate)
+	assert.Equal(t, "Sweet", *generate.SingleResult)
+	assert.Equal(t, "Both are round", *generate.GroupedResult)
+	assert.Equal(t, "Describe Hawaii", generate.Debug.Prompt)
+	assert.NoError(t, generate.Err())
+
+	generate = pizzas[1].Additional.Generate
+	require.NotNil(t, generate)
+	assert.Nil(t, generate.SingleResult)
+	assert.EqualError(t, generate.Err(), "generative search: rate limit exceeded")
+}
+
+func TestDecodeGroups(t *testing.T) {
+	type pizza struct {
+		Name       string     `json:"name"`
+		Additional Additional `json:"_additional"`
+	}
+
+	var response models.GraphQLResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"data":{"Get":{"Pizza":[
+		{"_additional":{"group":{"id":0,"groupedBy":{"value":"Italy","path":["origin"]},"count":2,"maxDistance":0.3,"minDistance":0.1,
+			"hits":[{"name":"Margherita","_additional":{"id":"5b6a08ba-1d46-43aa-89cc-8b070790c6f2","distance":0.1}},{"name":"Quattro Formaggi"}]}}},
+		{"_additional":{"group":{"id":1,"groupedBy":{"value":"USA","path":["origin"]},"count":1,"maxDistance":0.5,"minDistance":0.5,
+			"hits":[{"name":"Hawaii"}]}}}
+	]}}}`), &response))
+
+	groups, err := DecodeGroups(&response, "Pizza")
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+
+	assert.Equal(t, 0, groups[0].ID)
+	assert.Equal(t, GroupedBy{Value: "Italy", Path: []string{"origin"}}, groups[0].GroupedBy)
+	assert.Equal(t, 2, groups[0].Count)
+	assert.Equal(t, float32(0.3), groups[0].MaxDistance)
+	assert.Equal(t, float32(0.1), groups[0].MinDistance)
+
+	var hits []pizza
+	require.NoError(t, groups[0].DecodeHits(&hits))
+	require.Len(t, hits, 2)
+	assert.Equal(t, "Margherita", hits[0].Name)
+	assert.Equal(t, "5b6a08ba-1d46-43aa-89cc-8b070790c6f2", hits[0].Additional.ID)
+	assert.Equal(t, "Quattro Formaggi", hits[1].Name)
+
+	hits = nil
+	require.NoError(t, groups[1].DecodeHits(&hits))
+	assert.Equal(t, []pizza{{Name: "Hawaii"}}, hits)
+
+	t.Run("results without group", func(t *testing.T) {
+		var response models.GraphQLResponse
+		require.NoError(t, json.Unmarshal([]byte(`{"data":{"Get":{"Pizza":[{"name":"Hawaii"}]}}}`), &response))
+
+		_, err := DecodeGroups(&response, "Pizza")
+		assert.Error(t, err)
+	})
+}
+
+func TestParseExplainScore(t *testing.T) {
+	float := func(f float64) *float64 { return &f }
+
+	t.Run("relative score fusion", func(t *testing.T) {
+		explainScore := "\nHybrid (Result Set keyword,bm25) Document 5b6a08ba: original score 2.5, normalized score: 0.75 - " +
+			"\nHybrid (Result Set vector,hybridVector) Document 5b6a08ba: original score 0.8, normalized score: 0.25"
+
+		assert.Equal(t, []ScoreComponent{
+			{Source: "keyword,bm25", OriginalScore: float(2.5), NormalizedScore: float(0.75)},
+			{Source: "vector,hybridVector", OriginalScore: float(0.8), NormalizedScore: float(0.25)},
+		}, ParseExplainScore(explainScore))
+	})
+
+	t.Run("relative score fusion of older versions", func(t *testing.T) {
+		explainScore := "(vector) [0.1 0.2] : original score 0.9, normalized score: 0.3 - " +
+			"(bm25)BM25F_name_frequency:1, BM25F_name_propLength:2: original score 1.2e-01, normalized score: 0.7"
+
+		assert.Equal(t, []ScoreComponent{
+			{Source: "vector", OriginalScore: float(0.9), NormalizedScore: float(0.3)},
+			{Source: "bm25", OriginalScore: float(0.12), NormalizedScore: float(0.7)},
+		}, ParseExplainScore(explainScore))
+	})
+
+	t.Run("ranked fusion", func(t *testing.T) {
+		explainScore := "(bm25)BM25F_name_frequency:1\n(hybrid) Document 5b6a08ba contributed 0.0081967 to the score" +
+			"\n(hybrid) Document 5b6a08ba contributed 0.0163934 to the score"
+
+		assert.Equal(t, []ScoreComponent{
+			{Source: "bm25", Contribution: float(0.0081967)},
+			{Contribution: float(0.0163934)},
+		}, ParseExplainScore(explainScore))
+	})
+
+	t.Run("unknown explanation", func(t *testing.T) {
+		assert.Nil(t, ParseExplainScore("BM25F_name_frequency:1"))
+	})
+
+	t.Run("from additional", func(t *testing.T) {
+		additional := &Additional{Score: "0.5", ExplainScore: "(hybrid) Document a contributed 0.5 to the score"}
+
+		score, ok := additional.ScoreValue()
+		assert.True(t, ok)
+		assert.Equal(t, 0.5, score)
+		assert.Equal(t, []ScoreComponent{{Contribution: float(0.5)}}, additional.ScoreComponents())
+
+		_, ok = (&Additional{}).ScoreValue()
+		assert.False(t, ok)
+	})
+}
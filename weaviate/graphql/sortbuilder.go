@@ -3,6 +3,8 @@ package graphql
 import (
 	"fmt"
 	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
 )
 
 type Sort struct {
@@ -15,12 +17,12 @@ func (s Sort) build() string {
 	if len(s.Path) > 0 {
 		path := make([]string, len(s.Path))
 		for i := range s.Path {
-			path[i] = fmt.Sprintf("\"%s\"", s.Path[i])
+			path[i] = gql.Quote(s.Path[i])
 		}
 		clause = append(clause, fmt.Sprintf("path:[%s]", strings.Join(path, ", ")))
 	}
 	if len(s.Order) > 0 {
-		clause = append(clause, fmt.Sprintf("order:%s", string(s.Order)))
+		clause = append(clause, fmt.Sprintf("order:%s", gql.Enum(string(s.Order))))
 	}
 	return fmt.Sprintf("{%s}", strings.Join(clause, " "))
 }
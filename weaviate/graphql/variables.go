@@ -0,0 +1,96 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/filters"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/internal/gql"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// newVariables returns the collector for the values of a parameterized query or nil,
+// if the values should be inlined into the query
+func newVariables(parameterized bool) *gql.Variables {
+	if parameterized {
+		return gql.NewVariables()
+	}
+	return nil
+}
+
+// graphQLQuery with the variable definitions prepended to the selection
+func graphQLQuery(selection string, vars *gql.Variables) *models.GraphQLQuery {
+	query := &models.GraphQLQuery{Query: vars.Operation(selection)}
+	if values := vars.Values(); values != nil {
+		query.Variables = values
+	}
+	return query
+}
+
+// whereClause formats the where filter. If vars is not nil single filter values are passed
+// as variables. Their types are specific to the filtered class, scope is the prefix weaviate
+// uses for these types, e.g. GetObjectsPizza. Multiple values as used by ContainsAny and
+// ContainsAll are always inlined, as the filter value types only accept single values as variables.
+func whereClause(where *filters.WhereBuilder, vars *gql.Variables, scope string) string {
+	if vars == nil {
+		return where.String()
+	}
+	return fmt.Sprintf("where:{%s}", whereFilterClause(where.Build(), vars, scope))
+}
+
+func whereFilterClause(f *models.WhereFilter, vars *gql.Variables, scope string) string {
+	clause := []string{}
+	if f.Operator != "" {
+		clause = append(clause, fmt.Sprintf("operator: %s", gql.Enum(f.Operator)))
+	}
+	if len(f.Path) > 0 {
+		clause = append(clause, fmt.Sprintf("path: %s", gql.QuoteList(f.Path)))
+	}
+	value := func(name, gqlType string, single interface{}, multiple interface{}) {
+		if single != nil {
+			clause = append(clause, fmt.Sprintf("%s: %s", name, vars.Value(gqlType+scope, single)))
+		} else if multiple != nil {
+			clause = append(clause, fmt.Sprintf("%s: %s", name, gql.Literal(multiple)))
+		}
+	}
+	if f.ValueInt != nil || f.ValueIntArray != nil {
+		value("valueInt", "Int", pointerValue(f.ValueInt), f.ValueIntArray)
+	}
+	if f.ValueNumber != nil || f.ValueNumberArray != nil {
+		value("valueNumber", "Float", pointerValue(f.ValueNumber), f.ValueNumberArray)
+	}
+	if f.ValueBoolean != nil || f.ValueBooleanArray != nil {
+		value("valueBoolean", "Boolean", pointerValue(f.ValueBoolean), f.ValueBooleanArray)
+	}
+	if f.ValueString != nil || f.ValueStringArray != nil {
+		value("valueString", "TextString", pointerValue(f.ValueString), f.ValueStringArray)
+	}
+	if f.ValueText != nil || f.ValueTextArray != nil {
+		value("valueText", "Text", pointerValue(f.ValueText), f.ValueTextArray)
+	}
+	if f.ValueDate != nil || f.ValueDateArray != nil {
+		value("valueDate", "TextDate", pointerValue(f.ValueDate), f.ValueDateArray)
+	}
+	if f.ValueGeoRange != nil {
+		clause = append(clause, fmt.Sprintf("valueGeoRange: {geoCoordinates:{latitude:%v,longitude:%v},distance:{max:%v}}",
+			*f.ValueGeoRange.GeoCoordinates.Latitude, *f.ValueGeoRange.GeoCoordinates.Longitude,
+			float32(f.ValueGeoRange.Distance.Max)))
+	}
+	if len(f.Operands) > 0 {
+		operands := make([]string, len(f.Operands))
+		for i := range f.Operands {
+			operands[i] = fmt.Sprintf("{%s}", whereFilterClause(f.Operands[i], vars, scope))
+		}
+		clause = append(clause, fmt.Sprintf("operands:[%s]", strings.Join(operands, ",")))
+	}
+	return strings.Join(clause, " ")
+}
+
+// pointerValue returns the value p points to or nil, so that typed nil pointers are not
+// mistaken for values
+func pointerValue[T any](p *T) interface{} {
+	if p == nil {
+		return nil
+	}
+	return *p
+}
@@ -0,0 +1,108 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestQueryVariables(t *testing.T) {
+	okResponse := func() *MockRunREST {
+		return &MockRunREST{ReturnResponseData: &connection.ResponseData{StatusCode: 200, Body: []byte(`{"data":{}}`)}}
+	}
+
+	t.Run("get", func(t *testing.T) {
+		conMock := okResponse()
+		get := (&API{}).Get().WithClassName("Pizza").WithFields(Field{Name: "name"}).
+			WithQueryVariables(true).
+			WithTenant("tenantA").
+			WithWhere(filters.AllOf(
+				filters.TextProp("name").Equal("Hawaii"),
+				filters.NumberProp("price").LessThan(20),
+				filters.TextProp("tags").ContainsAny("vegan", "italian"),
+			)).
+			WithNearVector((&NearVectorArgumentBuilder{}).WithVector([]float32{0.1, 0.2}).WithDistance(0.5)).
+			WithGenerativeSearch(NewGenerativeSearch().SingleResult("Describe {name}"))
+		get.connection = conMock
+
+		_, err := get.Do(context.Background())
+		require.NoError(t, err)
+		query, ok := conMock.ArgRequestBody.(*models.GraphQLQuery)
+		require.True(t, ok)
+		assert.Equal(t, `query($v0: String, $v1: TextGetObjectsPizza, $v2: FloatGetObjectsPizza, $v3: [Float]!, $v4: String)`+
+			`{Get {Pizza (tenant: $v0, where:{operator: And operands:[`+
+			`{operator: Equal path: ["name"] valueText: $v1},`+
+			`{operator: LessThan path: ["price"] valueNumber: $v2},`+
+			`{operator: ContainsAny path: ["tags"] valueText: ["vegan","italian"]}]}, `+
+			`nearVector:{distance: 0.5 vector: $v3}) `+
+			`{name _additional{generate(singleResult:{prompt:$v4}){singleResult error}}}}}`, query.Query)
+		assert.Equal(t, map[string]interface{}{
+			"v0": "tenantA",
+			"v1": "Hawaii",
+			"v2": float64(20),
+			"v3": []float32{0.1, 0.2},
+			"v4": "Describe {name}",
+		}, query.Variables)
+	})
+
+	t.Run("query only depends on the structure", func(t *testing.T) {
+		build := func(query string, vector []float32) *models.GraphQLQuery {
+			return (&API{}).Get().WithClassName("Pizza").WithFields(Field{Name: "name"}).WithQueryVariables(true).
+				WithHybrid((&HybridArgumentBuilder{}).WithQuery(query).WithVector(vector)).buildQuery()
+		}
+		first, second := build("hawaii", []float32{1, 2}), build(`"}} {Get {Secret`, []float32{3})
+		assert.Equal(t, `query($v0: String, $v1: [Float]){Get {Pizza (hybrid:{query: $v0, vector: $v1}) {name}}}`, first.Query)
+		assert.Equal(t, first.Query, second.Query)
+		assert.Equal(t, `"}} {Get {Secret`, second.Variables.(map[string]interface{})["v0"])
+	})
+
+	t.Run("aggregate", func(t *testing.T) {
+		query := (&API{}).Aggregate().WithClassName("Pizza").WithFields(Field{Name: "meta{count}"}).
+			WithQueryVariables(true).
+			WithWhere(filters.IntProp("size").Equal(3)).
+			WithNearText((&NearTextArgumentBuilder{}).WithConcepts([]string{"hawaii"})).
+			buildQuery()
+		assert.Equal(t, `query($v0: IntAggregateObjectsPizza, $v1: [String]!)`+
+			`{Aggregate{Pizza(where:{operator: Equal path: ["size"] valueInt: $v0}, nearText:{concepts: $v1}){meta{count}}}}`,
+			query.Query)
+		assert.Equal(t, map[string]interface{}{"v0": int64(3), "v1": []string{"hawaii"}}, query.Variables)
+	})
+
+	t.Run("explore", func(t *testing.T) {
+		query := (&API{}).Explore().WithFields(Beacon).WithQueryVariables(true).
+			WithAsk((&AskArgumentBuilder{}).WithQuestion("What is the answer?")).
+			buildQuery()
+		assert.Equal(t, `query($v0: String!){Explore(ask:{question: $v0}){beacon }}`, query.Query)
+		assert.Equal(t, map[string]interface{}{"v0": "What is the answer?"}, query.Variables)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		get := (&API{}).Get().WithClassName("Pizza").WithFields(Field{Name: "name"}).
+			WithBM25((&BM25ArgumentBuilder{}).WithQuery("hawaii"))
+		query := get.buildQuery()
+		assert.Equal(t, get.build(), query.Query)
+		assert.Nil(t, query.Variables)
+	})
+
+	t.Run("raw", func(t *testing.T) {
+		conMock := okResponse()
+		raw := (&API{}).Raw().
+			WithQuery(`query pizzas($vector: [Float]!) {Get {Pizza(nearVector: {vector: $vector}) {name}}}`).
+			WithVariables(map[string]interface{}{"vector": []float32{0.1}}).
+			WithOperationName("pizzas")
+		raw.connection = conMock
+
+		_, err := raw.Do(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, &models.GraphQLQuery{
+			Query:         `query pizzas($vector: [Float]!) {Get {Pizza(nearVector: {vector: $vector}) {name}}}`,
+			Variables:     map[string]interface{}{"vector": []float32{0.1}},
+			OperationName: "pizzas",
+		}, conMock.ArgRequestBody)
+	})
+}
@@ -4,6 +4,11 @@ type Config struct {
 	Enabled bool
 	// Host of the weaviate instance; this is a mandatory field.
 	Host string
+	// Hosts of additional weaviate nodes, gRPC calls are spread across Host and Hosts according to
+	// the LoadBalancing config of the client
+	Hosts []string
 	// Scheme of the weaviate instance; this is a mandatory field.
 	Scheme string
+	// Compression enables gzip compression of gRPC calls
+	Compression bool
 }
@@ -0,0 +1,143 @@
+// Package gql contains the escaping and validation used when building GraphQL queries,
+// so that user input can never break or alter the structure of a query.
+package gql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	namePattern      = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+	classNamePattern = regexp.MustCompile(`^[A-Z][_0-9A-Za-z]*$`)
+)
+
+const hex = "0123456789abcdef"
+
+// Quote returns s as a GraphQL string literal. Quotes, backslashes and control characters
+// are escaped and invalid UTF-8 is replaced with the unicode replacement character.
+func Quote(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s) + 2)
+	sb.WriteByte('"')
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		switch {
+		case r == '"':
+			sb.WriteString(`\"`)
+		case r == '\\':
+			sb.WriteString(`\\`)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case r < 0x20 || r == 0x7f:
+			sb.WriteString(`\u00`)
+			sb.WriteByte(hex[r>>4])
+			sb.WriteByte(hex[r&0xf])
+		case r == utf8.RuneError && size == 1:
+			sb.WriteString(`�`)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// QuoteList returns the values as a GraphQL list of string literals
+func QuoteList(values []string) string {
+	quoted := make([]string, len(values))
+	for i := range values {
+		quoted[i] = Quote(values[i])
+	}
+	return fmt.Sprintf("[%s]", strings.Join(quoted, ","))
+}
+
+// IsName reports whether s is a valid GraphQL name, e.g. of an enum value
+func IsName(s string) bool {
+	return namePattern.MatchString(s)
+}
+
+// ValidateClassName returns an error if name is not a valid weaviate class name
+func ValidateClassName(name string) error {
+	if !classNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid class name %q: class names have to start with an uppercase letter "+
+			"followed by letters, digits or underscores", name)
+	}
+	return nil
+}
+
+// ValidatePropertyName returns an error if name is not a valid weaviate property name
+func ValidatePropertyName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("invalid property name %q: property names have to start with a letter or underscore "+
+			"followed by letters, digits or underscores", name)
+	}
+	return nil
+}
+
+// ValidateSelection returns an error if selection could alter the structure of the query it is
+// embedded in. A selection is raw GraphQL, e.g. a field name, "meta{count}", "... on Class{name}"
+// or `rerank(property:"name"){score}`, so its braces and parentheses have to be balanced outside
+// of strings, its strings have to be terminated and it must not contain comments or block strings.
+func ValidateSelection(selection string) error {
+	if strings.Contains(selection, `"""`) {
+		return fmt.Errorf("invalid selection %q: block strings are not allowed", selection)
+	}
+	var open []rune
+	inString, escaped := false, false
+	for _, r := range selection {
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == '"':
+				inString = false
+			case r == '\n' || r == '\r':
+				return fmt.Errorf("invalid selection %q: unterminated string", selection)
+			}
+			continue
+		}
+		switch r {
+		case '"':
+			inString = true
+		case '#':
+			return fmt.Errorf("invalid selection %q: comments are not allowed", selection)
+		case '{', '(':
+			open = append(open, r)
+		case '}', ')':
+			opening := '{'
+			if r == ')' {
+				opening = '('
+			}
+			if len(open) == 0 || open[len(open)-1] != opening {
+				return fmt.Errorf("invalid selection %q: unbalanced %q", selection, r)
+			}
+			open = open[:len(open)-1]
+		}
+	}
+	if inString {
+		return fmt.Errorf("invalid selection %q: unterminated string", selection)
+	}
+	if len(open) > 0 {
+		return fmt.Errorf("invalid selection %q: unclosed %q", selection, open[len(open)-1])
+	}
+	return nil
+}
+
+// Enum returns value as a GraphQL enum value. Values which are not valid names are
+// quoted, so that weaviate rejects them instead of them altering the query.
+func Enum(value string) string {
+	if IsName(value) {
+		return value
+	}
+	return Quote(value)
+}
@@ -0,0 +1,57 @@
+package gql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuote(t *testing.T) {
+	tests := map[string]string{
+		"plain":                  `"plain"`,
+		`say "hi"`:               `"say \"hi\""`,
+		`back\slash`:             `"back\\slash"`,
+		"multi\nline\r\ttext":    `"multi\nline\r\ttext"`,
+		"nul\x00bell\x07del\x7f": `"nul\u0000bell\u0007del\u007f"`,
+		"invalid \xff utf8":      `"invalid � utf8"`,
+		"unicode ä 🍕":            `"unicode ä 🍕"`,
+		`"""}} injected {{"""`:   `"\"\"\"}} injected {{\"\"\""`,
+	}
+	for input, expected := range tests {
+		assert.Equal(t, expected, Quote(input))
+	}
+	assert.Equal(t, `["a","b\"c"]`, QuoteList([]string{"a", `b"c`}))
+	assert.Equal(t, `[]`, QuoteList(nil))
+}
+
+func TestValidateNames(t *testing.T) {
+	assert.NoError(t, ValidateClassName("Pizza_2"))
+	assert.Error(t, ValidateClassName("pizza"))
+	assert.Error(t, ValidateClassName("Pizza{Get"))
+	assert.Error(t, ValidateClassName(""))
+
+	assert.NoError(t, ValidatePropertyName("_name2"))
+	assert.NoError(t, ValidatePropertyName("Name"))
+	assert.Error(t, ValidatePropertyName("name)"))
+	assert.Error(t, ValidatePropertyName("2name"))
+
+	assert.True(t, IsName("ONE"))
+	assert.False(t, IsName("ONE)"))
+}
+
+func TestValidateSelection(t *testing.T) {
+	for _, selection := range []string{
+		"name", "meta{count}", "... on Publication", "ofDocument{... on Document{_additional{id}}}",
+		"alias: name", "_additional{certainty}", `_additional{rerank(property:"x"){score}}`,
+		`_additional{rerank(property:"content", query:"a \"quoted\" } # (text\\"){score}}`,
+	} {
+		assert.NoError(t, ValidateSelection(selection), selection)
+	}
+	for _, selection := range []string{
+		"name}} Aggregate{Pizza{meta{count}", "name{", "name)", "name(arg:{)}",
+		"name # comment", `name(where:"x)`, `name(where:"x\")`, "name(where:\"x\n\"){a}",
+		`name(where:"""x""")`, `name(where:"x")}`,
+	} {
+		assert.Error(t, ValidateSelection(selection), selection)
+	}
+}
@@ -0,0 +1,163 @@
+package gql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Variables collects the values of a parameterized query. A nil *Variables is valid
+// and inlines all values as literals into the query.
+type Variables struct {
+	definitions []string
+	values      map[string]interface{}
+}
+
+// NewVariables for a parameterized query
+func NewVariables() *Variables {
+	return &Variables{values: map[string]interface{}{}}
+}
+
+// Value adds value as a new variable of gqlType, e.g. [Float]!, and returns the reference
+// to it. If v is nil the value is returned as literal instead.
+func (v *Variables) Value(gqlType string, value interface{}) string {
+	if v == nil {
+		return Literal(value)
+	}
+	name := fmt.Sprintf("v%d", len(v.definitions))
+	v.definitions = append(v.definitions, fmt.Sprintf("$%s: %s", name, gqlType))
+	v.values[name] = value
+	return "$" + name
+}
+
+// Operation prefixes the selection with the variable definitions, e.g. query($v0: String){...}
+func (v *Variables) Operation(selection string) string {
+	if v == nil || len(v.definitions) == 0 {
+		return selection
+	}
+	return fmt.Sprintf("query(%s)%s", strings.Join(v.definitions, ", "), selection)
+}
+
+// Values of the variables or nil if there are none
+func (v *Variables) Values() map[string]interface{} {
+	if v == nil || len(v.values) == 0 {
+		return nil
+	}
+	return v.values
+}
+
+// Literal formats value as GraphQL literal. Strings are quoted, lists and maps with string keys
+// are formatted as GraphQL lists and input objects, everything else like its JSON representation.
+// Values which can't be represented in GraphQL, e.g. NaN, are formatted so that weaviate rejects
+// the query. ValidateLiteral reports them before the query is sent.
+func Literal(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return Quote(v)
+	case []string:
+		return QuoteList(v)
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String:
+		return Quote(rv.String())
+	case reflect.Float32, reflect.Float64:
+		if f := rv.Float(); math.IsNaN(f) || math.IsInf(f, 0) {
+			// NaN, +Inf and -Inf are no GraphQL values
+			return strconv.FormatFloat(f, 'g', -1, 64)
+		}
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return "null"
+		}
+		return Literal(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return "null"
+		}
+		items := make([]string, rv.Len())
+		for i := range items {
+			items[i] = Literal(rv.Index(i).Interface())
+		}
+		return fmt.Sprintf("[%s]", strings.Join(items, ","))
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			break
+		}
+		fields := make([]string, 0, rv.Len())
+		for _, key := range sortedKeys(rv) {
+			name := key.String()
+			if !IsName(name) {
+				name = Quote(name)
+			}
+			fields = append(fields, fmt.Sprintf("%s:%s", name, Literal(rv.MapIndex(key).Interface())))
+		}
+		return fmt.Sprintf("{%s}", strings.Join(fields, ","))
+	}
+	literal, err := json.Marshal(value)
+	if err != nil {
+		// not a GraphQL value either
+		return fmt.Sprintf("<%T>", value)
+	}
+	return string(literal)
+}
+
+// ValidateLiteral returns an error if value can't be formatted as GraphQL literal
+func ValidateLiteral(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return nil
+	case reflect.Float32, reflect.Float64:
+		if f := rv.Float(); math.IsNaN(f) || math.IsInf(f, 0) {
+			return fmt.Errorf("unsupported number %v", f)
+		}
+		return nil
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return ValidateLiteral(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if err := ValidateLiteral(rv.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("unsupported map key type %s", rv.Type().Key())
+		}
+		for _, key := range sortedKeys(rv) {
+			if !IsName(key.String()) {
+				return fmt.Errorf("invalid object field name %q", key.String())
+			}
+			if err := ValidateLiteral(rv.MapIndex(key).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported value of type %T", value)
+}
+
+// sortedKeys of a map with string keys, so that the formatted literal is deterministic
+func sortedKeys(rv reflect.Value) []reflect.Value {
+	keys := rv.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	return keys
+}
@@ -0,0 +1,45 @@
+package gql
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiteral(t *testing.T) {
+	value := 0.5
+	tests := []struct {
+		value   interface{}
+		literal string
+	}{
+		{value: nil, literal: "null"},
+		{value: `say "hi"`, literal: `"say \"hi\""`},
+		{value: []string{"a", "b"}, literal: `["a","b"]`},
+		{value: true, literal: "true"},
+		{value: 42, literal: "42"},
+		{value: float32(0.1), literal: "0.1"},
+		{value: 1e-7, literal: "1e-7"},
+		{value: []float32{1, 2.5}, literal: "[1,2.5]"},
+		{value: &value, literal: "0.5"},
+		{value: map[string]interface{}{"b": 1, "a": []int{1}}, literal: "{a:[1],b:1}"},
+		{value: math.NaN(), literal: "NaN"},
+		{value: math.Inf(-1), literal: "-Inf"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.literal, Literal(tt.value))
+	}
+}
+
+func TestValidateLiteral(t *testing.T) {
+	assert.NoError(t, ValidateLiteral(nil))
+	assert.NoError(t, ValidateLiteral("text"))
+	assert.NoError(t, ValidateLiteral([]interface{}{1, 0.5, true, map[string]string{"a": "b"}}))
+
+	assert.Error(t, ValidateLiteral(math.NaN()))
+	assert.Error(t, ValidateLiteral([]float32{1, float32(math.Inf(1))}))
+	assert.Error(t, ValidateLiteral(map[string]int{"a b": 1}))
+	assert.Error(t, ValidateLiteral(map[int]int{1: 1}))
+	assert.Error(t, ValidateLiteral(struct{}{}))
+	assert.Error(t, ValidateLiteral(func() {}))
+}
@@ -75,5 +75,5 @@ func (oidcg *OpenIDConfigGetter) Do(ctx context.Context) (*OpenIDConfiguration,
 		return &openIDConfig, decodeErr
 	}
 
-	return nil, except.NewWeaviateClientError(response.StatusCode, string(response.Body))
+	return nil, except.NewUnexpectedStatusCodeErrorFromRESTResponse(response)
 }
@@ -11,8 +11,24 @@ import (
 
 // ClassCreator builder object to create a schema class
 type ClassCreator struct {
-	connection *connection.Connection
-	class      *models.Class
+	connection   *connection.Connection
+	class        *models.Class
+	vectorConfig map[string]VectorConfig
+}
+
+// VectorConfig of a named vector of a class
+type VectorConfig struct {
+	// Vectorizer by module name with the module's settings, e.g. {"text2vec-openai": {"properties": ["title"]}}
+	Vectorizer        map[string]interface{} `json:"vectorizer"`
+	VectorIndexType   string                 `json:"vectorIndexType,omitempty"`
+	VectorIndexConfig interface{}            `json:"vectorIndexConfig,omitempty"`
+}
+
+// classWithVectorConfig adds the named vectors to the class model, which has no field for them
+// in the weaviate version this client is built against
+type classWithVectorConfig struct {
+	*models.Class
+	VectorConfig map[string]VectorConfig `json:"vectorConfig,omitempty"`
 }
 
 // WithClass specifies the class that will be added to the schema
@@ -21,8 +37,19 @@ func (cc *ClassCreator) WithClass(class *models.Class) *ClassCreator {
 	return cc
 }
 
+// WithVectorConfig configures multiple named vectors of the class by their names.
+// The class' own vectorizer and vector index settings must not be set in this case.
+func (cc *ClassCreator) WithVectorConfig(vectorConfig map[string]VectorConfig) *ClassCreator {
+	cc.vectorConfig = vectorConfig
+	return cc
+}
+
 // Do create a class in the schema as specified in the builder
 func (cc *ClassCreator) Do(ctx context.Context) error {
-	responseData, err := cc.connection.RunREST(ctx, "/schema", http.MethodPost, cc.class)
+	var class interface{} = cc.class
+	if len(cc.vectorConfig) > 0 {
+		class = &classWithVectorConfig{Class: cc.class, VectorConfig: cc.vectorConfig}
+	}
+	responseData, err := cc.connection.RunREST(ctx, "/schema", http.MethodPost, class)
 	return except.CheckResponseDataErrorAndStatusCode(responseData, err, 200)
 }
@@ -33,5 +33,5 @@ func (c *ClassGetter) Do(ctx context.Context) (*models.Class, error) {
 		decodeErr := responseData.DecodeBodyIntoTarget(&object)
 		return &object, decodeErr
 	}
-	return nil, except.NewWeaviateClientError(responseData.StatusCode, string(responseData.Body))
+	return nil, except.NewUnexpectedStatusCodeErrorFromRESTResponse(responseData)
 }
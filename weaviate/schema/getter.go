@@ -30,5 +30,5 @@ func (sg *Getter) Do(ctx context.Context) (*Dump, error) {
 		decodeErr := responseData.DecodeBodyIntoTarget(&fullSchema)
 		return &fullSchema, decodeErr
 	}
-	return nil, except.NewWeaviateClientError(responseData.StatusCode, string(responseData.Body))
+	return nil, except.NewUnexpectedStatusCodeErrorFromRESTResponse(responseData)
 }
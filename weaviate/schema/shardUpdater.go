@@ -54,5 +54,5 @@ func updateShard(ctx context.Context, conn *connection.Connection, className, sh
 		decodeErr := responseData.DecodeBodyIntoTarget(&shard)
 		return &shard, decodeErr
 	}
-	return nil, except.NewWeaviateClientError(responseData.StatusCode, string(responseData.Body))
+	return nil, except.NewUnexpectedStatusCodeErrorFromRESTResponse(responseData)
 }
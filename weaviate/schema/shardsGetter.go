@@ -37,5 +37,5 @@ func getShards(ctx context.Context, conn *connection.Connection, className strin
 		decodeErr := responseData.DecodeBodyIntoTarget(&shards)
 		return shards, decodeErr
 	}
-	return nil, except.NewWeaviateClientError(responseData.StatusCode, string(responseData.Body))
+	return nil, except.NewUnexpectedStatusCodeErrorFromRESTResponse(responseData)
 }
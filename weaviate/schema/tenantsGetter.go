@@ -33,5 +33,5 @@ func (tg *TenantsGetter) Do(ctx context.Context) ([]models.Tenant, error) {
 		decodeErr := responseData.DecodeBodyIntoTarget(&tenants)
 		return tenants, decodeErr
 	}
-	return nil, except.NewWeaviateClientError(responseData.StatusCode, string(responseData.Body))
+	return nil, except.NewUnexpectedStatusCodeErrorFromRESTResponse(responseData)
 }
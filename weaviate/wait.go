@@ -0,0 +1,201 @@
+package weaviate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Probe decides which check is used to determine if weaviate is up
+type Probe string
+
+const (
+	// ProbeReady checks the /.well-known/ready endpoint
+	ProbeReady Probe = "ready"
+	// ProbeLive checks the /.well-known/live endpoint
+	ProbeLive Probe = "live"
+	// ProbeGRPCHealth checks the gRPC health service, requires gRPC to be enabled
+	ProbeGRPCHealth Probe = "grpc_health"
+)
+
+const (
+	defaultInitialBackoff    = 100 * time.Millisecond
+	defaultMaxBackoff        = 5 * time.Second
+	defaultBackoffMultiplier = 2
+	defaultAttemptTimeout    = time.Second
+)
+
+// WaitOptions configure how Client.WaitForReady waits for weaviate
+type WaitOptions struct {
+	// Probe used to check weaviate. Defaults to ProbeReady.
+	Probe Probe
+	// InitialBackoff between two attempts. Defaults to 100ms.
+	InitialBackoff time.Duration
+	// MaxBackoff between two attempts. Defaults to 5s.
+	MaxBackoff time.Duration
+	// BackoffMultiplier the backoff is multiplied with after every failed attempt. Defaults to 2.
+	BackoffMultiplier float64
+	// AttemptTimeout of a single attempt. Defaults to 1s.
+	AttemptTimeout time.Duration
+	// Classes that have to be present in the schema
+	Classes []string
+	// Modules that have to be enabled
+	Modules []string
+}
+
+// WaitError is returned by Client.WaitForReady if weaviate did not become ready
+type WaitError struct {
+	// Probe that was used
+	Probe Probe
+	// Attempts made before giving up
+	Attempts int
+	// LastErr is the failure of the last attempt
+	LastErr error
+	// MissingClasses of the last attempt
+	MissingClasses []string
+	// MissingModules of the last attempt
+	MissingModules []string
+	// Err is the reason waiting was stopped, usually the error of the context
+	Err error
+}
+
+// Error message of the wait error
+func (e *WaitError) Error() string {
+	msg := fmt.Sprintf("weaviate is not ready after %d attempts (probe: %s): %v", e.Attempts, e.Probe, e.Err)
+	if e.LastErr != nil {
+		msg = fmt.Sprintf("%s, last failure: %v", msg, e.LastErr)
+	}
+	return msg
+}
+
+// Unwrap returns the reason waiting was stopped
+func (e *WaitError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForReady waits until weaviate passes the configured probe and all the required classes and modules
+// are present. Waiting can be cancelled with the context; on failure a *WaitError describing the last
+// failed attempt is returned.
+func (c *Client) WaitForReady(ctx context.Context, opts WaitOptions) error {
+	opts = opts.withDefaults()
+	switch opts.Probe {
+	case ProbeReady, ProbeLive:
+	case ProbeGRPCHealth:
+		if c.grpcClient == nil {
+			return &WaitError{Probe: opts.Probe, Err: errors.New("gRPC is not enabled")}
+		}
+	default:
+		return &WaitError{Probe: opts.Probe, Err: fmt.Errorf("unknown probe %q", opts.Probe)}
+	}
+	backoff := opts.InitialBackoff
+	waitErr := &WaitError{Probe: opts.Probe}
+	for {
+		waitErr.Attempts++
+		attemptCtx, cancel := context.WithTimeout(ctx, opts.AttemptTimeout)
+		waitErr.MissingClasses, waitErr.MissingModules, waitErr.LastErr = c.checkReady(attemptCtx, opts)
+		cancel()
+		if waitErr.LastErr == nil {
+			return nil
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			waitErr.Err = ctx.Err()
+			return waitErr
+		case <-timer.C:
+		}
+		backoff = time.Duration(float64(backoff) * opts.BackoffMultiplier)
+		if backoff > opts.MaxBackoff {
+			backoff = opts.MaxBackoff
+		}
+	}
+}
+
+func (opts WaitOptions) withDefaults() WaitOptions {
+	if opts.Probe == "" {
+		opts.Probe = ProbeReady
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = defaultInitialBackoff
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = defaultMaxBackoff
+	}
+	if opts.BackoffMultiplier < 1 {
+		opts.BackoffMultiplier = defaultBackoffMultiplier
+	}
+	if opts.AttemptTimeout <= 0 {
+		opts.AttemptTimeout = defaultAttemptTimeout
+	}
+	return opts
+}
+
+func (c *Client) checkReady(ctx context.Context, opts WaitOptions) (missingClasses, missingModules []string, err error) {
+	if err = c.checkProbe(ctx, opts.Probe); err != nil {
+		return nil, nil, err
+	}
+	if len(opts.Modules) > 0 {
+		meta, err := c.misc.MetaGetter().Do(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		modules, _ := meta.Modules.(map[string]interface{})
+		for _, module := range opts.Modules {
+			if _, ok := modules[module]; !ok {
+				missingModules = append(missingModules, module)
+			}
+		}
+	}
+	if len(opts.Classes) > 0 {
+		schema, err := c.schema.Getter().Do(ctx)
+		if err != nil {
+			return nil, missingModules, err
+		}
+		present := map[string]bool{}
+		for _, class := range schema.Classes {
+			present[class.Class] = true
+		}
+		for _, class := range opts.Classes {
+			if !present[class] {
+				missingClasses = append(missingClasses, class)
+			}
+		}
+	}
+	if len(missingModules) > 0 || len(missingClasses) > 0 {
+		var missing []string
+		if len(missingModules) > 0 {
+			missing = append(missing, fmt.Sprintf("modules %s", strings.Join(missingModules, ", ")))
+		}
+		if len(missingClasses) > 0 {
+			missing = append(missing, fmt.Sprintf("classes %s", strings.Join(missingClasses, ", ")))
+		}
+		return missingClasses, missingModules, fmt.Errorf("missing %s", strings.Join(missing, " and "))
+	}
+	return nil, nil, nil
+}
+
+func (c *Client) checkProbe(ctx context.Context, probe Probe) error {
+	var ok bool
+	var err error
+	switch probe {
+	case ProbeReady:
+		ok, err = c.misc.ReadyChecker().Do(ctx)
+	case ProbeLive:
+		ok, err = c.misc.LiveChecker().Do(ctx)
+	case ProbeGRPCHealth:
+		return c.grpcClient.CheckHealth(ctx)
+	default:
+		return fmt.Errorf("unknown probe %q", probe)
+	}
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%s probe failed", probe)
+	}
+	return nil
+}
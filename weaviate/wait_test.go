@@ -0,0 +1,26 @@
+package weaviate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WaitForReady_invalidOptions(t *testing.T) {
+	t.Run("unknown probe", func(t *testing.T) {
+		err := (&Client{}).WaitForReady(context.Background(), WaitOptions{Probe: "startup"})
+		var waitErr *WaitError
+		require.ErrorAs(t, err, &waitErr)
+		assert.Equal(t, 0, waitErr.Attempts)
+		assert.EqualError(t, waitErr.Err, `unknown probe "startup"`)
+	})
+
+	t.Run("gRPC health without gRPC", func(t *testing.T) {
+		err := (&Client{}).WaitForReady(context.Background(), WaitOptions{Probe: ProbeGRPCHealth})
+		var waitErr *WaitError
+		require.ErrorAs(t, err, &waitErr)
+		assert.EqualError(t, waitErr.Err, "gRPC is not enabled")
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/grpc"
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/misc"
 	"github.com/weaviate/weaviate-go-client/v4/weaviate/schema"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
 // Config of the client endpoint
@@ -28,6 +29,15 @@ type Config struct {
 	// Scheme of the weaviate instance; this is a mandatory field.
 	Scheme string
 
+	// Hosts of additional weaviate nodes of the same cluster. Requests are spread across Host and Hosts.
+	Hosts []string
+
+	// Configuration of how requests are spread across the nodes of a cluster
+	LoadBalancing connection.LoadBalancingConfig
+
+	// Configuration of the gzip compression of REST requests and responses
+	Compression connection.CompressionConfig
+
 	// ConnectionClient that will be used to execute http requests to the weaviate instance.
 	//  If omitted a default will be used. The default is not able to handle authenticated requests.
 	//
@@ -94,6 +104,7 @@ type Client struct {
 	backup          *backup.API
 	graphQL         *graphql.API
 	cluster         *cluster.API
+	nodeHosts       map[string]string
 }
 
 func NewClient(config Config) (*Client, error) {
@@ -123,7 +134,9 @@ func NewClient(config Config) (*Client, error) {
 
 	}
 
-	con := connection.NewConnection(config.Scheme, config.Host, config.ConnectionClient, config.Headers)
+	con := connection.NewLoadBalancedConnection(config.Scheme, append([]string{config.Host}, config.Hosts...),
+		config.ConnectionClient, config.Headers, config.LoadBalancing)
+	con.SetCompression(config.Compression)
 
 	if err := con.WaitForWeaviate(config.StartupTimeout); err != nil {
 		return nil, err
@@ -160,6 +173,13 @@ func NewClient(config Config) (*Client, error) {
 		batch:           batch.New(con, grpcClient, dbVersionSupport),
 		backup:          backup.New(con),
 		cluster:         cluster.New(con),
+		nodeHosts:       config.LoadBalancing.NodeHosts,
+	}
+
+	if config.LoadBalancing.RefreshNodes {
+		if err := client.RefreshNodes(context.Background()); err != nil {
+			return nil, err
+		}
 	}
 
 	return client, nil
@@ -171,7 +191,9 @@ func NewClient(config Config) (*Client, error) {
 // The client uses the original data models as provided by weaviate itself.
 // All these models are provided in the sub module "github.com/weaviate/weaviate/entities/models"
 func New(config Config) *Client {
-	con := connection.NewConnection(config.Scheme, config.Host, config.ConnectionClient, config.Headers)
+	con := connection.NewLoadBalancedConnection(config.Scheme, append([]string{config.Host}, config.Hosts...),
+		config.ConnectionClient, config.Headers, config.LoadBalancing)
+	con.SetCompression(config.Compression)
 
 	grpcClient, err := createGrpcClient(config)
 	if err != nil {
@@ -204,6 +226,7 @@ func New(config Config) *Client {
 		batch:           batch.New(con, grpcClient, dbVersionSupport),
 		backup:          backup.New(con),
 		cluster:         cluster.New(con),
+		nodeHosts:       config.LoadBalancing.NodeHosts,
 	}
 
 	return client
@@ -214,6 +237,49 @@ func (c *Client) WaitForWeavaite(startupTimeout time.Duration) error {
 	return c.connection.WaitForWeaviate(startupTimeout)
 }
 
+// Close releases all resources held by the client. It cancels requests and batches in flight,
+// stops background goroutines, closes idle http connections and the gRPC connection.
+// Every call made with the client afterwards fails with fault.ErrClientClosed.
+func (c *Client) Close() error {
+	c.connection.Close()
+	if c.grpcClient != nil {
+		return c.grpcClient.Close()
+	}
+	return nil
+}
+
+// CompressionStats returns the number of bytes sent and received by the REST and gRPC
+// connections before and after compression
+func (c *Client) CompressionStats() connection.CompressionStats {
+	stats := c.connection.CompressionStats()
+	if c.grpcClient != nil {
+		stats = stats.Add(c.grpcClient.CompressionStats())
+	}
+	return stats
+}
+
+// RefreshNodes fetches the status of the cluster nodes and updates the health of the nodes
+// requests are spread across. The nodes status endpoint doesn't report node addresses, so new
+// hosts can't be found this way: nodes are resolved to hosts through LoadBalancingConfig.NodeHosts
+// and nodes without a known host are skipped. Nodes that are not healthy are ejected until they
+// are reported healthy again. Only the nodes of REST requests are refreshed, gRPC hosts are
+// ejected when their calls fail.
+func (c *Client) RefreshNodes(ctx context.Context) error {
+	nodesStatus, err := c.cluster.NodesStatusGetter().Do(ctx)
+	if err != nil {
+		return err
+	}
+	for _, node := range nodesStatus.Nodes {
+		host, ok := c.nodeHosts[node.Name]
+		if !ok {
+			continue
+		}
+		healthy := node.Status != nil && *node.Status == models.NodeStatusStatusHEALTHY
+		c.connection.SetNode(node.Name, host, healthy)
+	}
+	return nil
+}
+
 // Misc collection group for .well_known and root level API commands
 func (c *Client) Misc() *misc.API {
 	return c.misc
@@ -269,7 +335,13 @@ func createGrpcClient(config Config) (*connection.GrpcClient, error) {
 		host = config.GrpcConfig.Host
 	}
 	if config.GrpcConfig.Enabled {
-		return connection.NewGrpcClient(scheme, host, config.Headers)
+		grpcClient, err := connection.NewLoadBalancedGrpcClient(scheme, append([]string{host}, config.GrpcConfig.Hosts...),
+			config.Headers, config.LoadBalancing)
+		if err != nil {
+			return nil, err
+		}
+		grpcClient.SetCompression(config.GrpcConfig.Compression)
+		return grpcClient, nil
 	}
 	return nil, nil
 }